@@ -42,10 +42,11 @@ func main() {
 			continue
 		}
 
-		fmt.Printf("  Sentiment: %s\n", result.Label)
+		fmt.Printf("  Sentiment: %s\n", result.Sentiment)
 		fmt.Printf("  Confidence: %.2f%%\n", result.Confidence())
 		fmt.Printf("  Is Positive: %t\n", result.IsPositive())
 		fmt.Printf("  Is Negative: %t\n", result.IsNegative())
+		fmt.Printf("  Margin: %.2f\n", result.Margin())
 		fmt.Println()
 
 		// Demonstrate retry functionality
@@ -64,6 +65,7 @@ func main() {
 		Address:    "localhost:50051",
 		Timeout:    5 * time.Second,
 		MaxRetries: 1,
+		Insecure:   true,
 	})
 	if err != nil {
 		log.Printf("Failed to create custom client: %v", err)
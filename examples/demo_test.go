@@ -2,15 +2,36 @@ package main
 
 import (
 	"context"
+	"os"
 	"testing"
 	"time"
 
 	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
 )
 
-// Note: These tests require the NLP server to be running
-// Start the server with: docker-compose up nlp-engine
-// Or manually: cd nlp-engine && python server.py
+// These tests run against an in-memory server by default. To run them against
+// a real engine, set NLP_SERVER_ADDRESS, e.g.:
+//
+//	docker-compose up nlp-engine
+//	NLP_SERVER_ADDRESS=localhost:50051 go test ./...
+
+// newTestClient returns a client for NLP_SERVER_ADDRESS when set, or for an in-memory server
+func newTestClient(tb testing.TB) *go_sdk.Client {
+	tb.Helper()
+
+	addr := os.Getenv("NLP_SERVER_ADDRESS")
+	if addr == "" {
+		return zennlptest.NewServer(tb).Client(tb)
+	}
+
+	client, err := go_sdk.NewClient(addr)
+	if err != nil {
+		tb.Fatalf("cannot connect to server at %s: %v", addr, err)
+	}
+	tb.Cleanup(func() { client.Close() })
+	return client
+}
 
 // TestClientInitialization tests the SDK client initialization
 func TestClientInitialization(t *testing.T) {
@@ -32,6 +53,7 @@ func TestClientWithConfig(t *testing.T) {
 		Address:    "localhost:50051",
 		Timeout:    5 * time.Second,
 		MaxRetries: 2,
+		Insecure:   true,
 	}
 
 	client, err := go_sdk.NewClientWithConfig(config)
@@ -48,12 +70,7 @@ func TestClientWithConfig(t *testing.T) {
 
 // TestSentimentAnalysisPositive tests positive sentiment analysis
 func TestSentimentAnalysisPositive(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -79,12 +96,7 @@ func TestSentimentAnalysisPositive(t *testing.T) {
 
 // TestSentimentAnalysisNegative tests negative sentiment analysis
 func TestSentimentAnalysisNegative(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -110,12 +122,7 @@ func TestSentimentAnalysisNegative(t *testing.T) {
 
 // TestAnalyzeWithLanguage tests sentiment analysis with explicit language
 func TestAnalyzeWithLanguage(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -136,12 +143,7 @@ func TestAnalyzeWithLanguage(t *testing.T) {
 
 // TestAnalyzeWithRetry tests the retry functionality
 func TestAnalyzeWithRetry(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
 	defer cancel()
@@ -162,12 +164,7 @@ func TestAnalyzeWithRetry(t *testing.T) {
 
 // TestMultipleSentimentAnalyses tests multiple consecutive analyses
 func TestMultipleSentimentAnalyses(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -201,14 +198,128 @@ func TestMultipleSentimentAnalyses(t *testing.T) {
 	}
 }
 
-// TestResultMethods tests the Result helper methods
-func TestResultMethods(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
+// TestAnalyzeBatch tests batch sentiment analysis preserves input order
+func TestAnalyzeBatch(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	texts := []string{
+		"این محصول عالی است و من خیلی راضی هستم",
+		"کیفیت بسیار بد بود و اصلا توصیه نمی‌کنم",
+		"قیمت مناسبی دارد و کیفیت خوبی هم دارد",
+	}
+
+	results, err := client.AnalyzeBatch(ctx, texts)
 	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
+		t.Fatalf("AnalyzeBatch() error = %v", err)
 	}
-	defer client.Close()
+
+	if len(results) != len(texts) {
+		t.Fatalf("expected %d results, got %d", len(texts), len(results))
+	}
+
+	for i, result := range results {
+		if result == nil {
+			t.Errorf("expected non-nil result for item %d", i)
+			continue
+		}
+		t.Logf("Item %d: Sentiment=%s, Confidence=%.2f%%", i, result.Label, result.Confidence())
+	}
+}
+
+// TestAnalyzeAll tests concurrent fan-out reports every input index once
+func TestAnalyzeAll(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	texts := []string{
+		"این محصول عالی است و من خیلی راضی هستم",
+		"کیفیت بسیار بد بود و اصلا توصیه نمی‌کنم",
+		"قیمت مناسبی دارد و کیفیت خوبی هم دارد",
+		"بسیار ناامید شدم، این محصول ارزش خرید ندارد",
+	}
+
+	seen := make(map[int]bool)
+	for res := range client.AnalyzeAll(ctx, texts, go_sdk.AnalyzeAllOptions{Concurrency: 2, ItemTimeout: 10 * time.Second}) {
+		if seen[res.Index] {
+			t.Errorf("index %d reported twice", res.Index)
+		}
+		seen[res.Index] = true
+
+		if res.Err != nil {
+			t.Errorf("text %d failed: %v", res.Index, res.Err)
+			continue
+		}
+		t.Logf("Text %d: Sentiment=%s, Confidence=%.2f%%", res.Index, res.Result.Label, res.Result.Confidence())
+	}
+
+	if len(seen) != len(texts) {
+		t.Errorf("expected %d results, got %d", len(texts), len(seen))
+	}
+}
+
+// TestStreamSentiment tests that streamed responses are correlated by request ID
+func TestStreamSentiment(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	stream, err := client.Stream(ctx)
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	defer stream.Close()
+
+	texts := map[string]string{
+		"a": "این محصول عالی است و من خیلی راضی هستم",
+		"b": "کیفیت بسیار بد بود و اصلا توصیه نمی‌کنم",
+		"c": "قیمت مناسبی دارد و کیفیت خوبی هم دارد",
+	}
+
+	sendErr := make(chan error, 1)
+	go func() {
+		for id, text := range texts {
+			if err := stream.Send(id, text); err != nil {
+				sendErr <- err
+				return
+			}
+		}
+		sendErr <- stream.CloseSend()
+	}()
+
+	received := make(map[string]bool)
+	for range texts {
+		res, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if _, ok := texts[res.ID]; !ok {
+			t.Errorf("unexpected response ID %q", res.ID)
+		}
+		if res.Err != nil {
+			t.Errorf("request %s failed: %v", res.ID, res.Err)
+			continue
+		}
+		received[res.ID] = true
+		t.Logf("%s: Sentiment=%s, Confidence=%.2f%%", res.ID, res.Result.Label, res.Result.Confidence())
+	}
+
+	if err := <-sendErr; err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if len(received) != len(texts) {
+		t.Errorf("expected %d responses, got %d", len(texts), len(received))
+	}
+}
+
+// TestResultMethods tests the Result helper methods
+func TestResultMethods(t *testing.T) {
+	client := newTestClient(t)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
@@ -230,16 +341,24 @@ func TestResultMethods(t *testing.T) {
 	if confidence < 0 || confidence > 100 {
 		t.Errorf("confidence should be between 0 and 100, got %.2f", confidence)
 	}
+
+	// Test that the probability distribution is consistent with the label
+	for sentiment, p := range result.Probabilities() {
+		if p < 0 || p > 1 {
+			t.Errorf("probability of %s should be between 0 and 1, got %.2f", sentiment, p)
+		}
+	}
+	if margin := result.Margin(); margin < 0 || margin > 1 {
+		t.Errorf("margin should be between 0 and 1, got %.2f", margin)
+	}
+	if result.IsPositive() && result.IsNeutral() {
+		t.Error("result cannot be both positive and neutral")
+	}
 }
 
 // TestContextTimeout tests that context timeout is respected
 func TestContextTimeout(t *testing.T) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		t.Skipf("Skipping test - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(t)
 
 	// Very short timeout to test timeout handling
 	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Nanosecond)
@@ -247,7 +366,7 @@ func TestContextTimeout(t *testing.T) {
 
 	text := "این محصول عالی است"
 
-	_, err = client.Analyze(ctx, text)
+	_, err := client.Analyze(ctx, text)
 	// We expect this to fail due to timeout or context cancellation
 	if err == nil {
 		t.Log("Expected timeout error, but analysis succeeded (server may be very fast)")
@@ -256,12 +375,7 @@ func TestContextTimeout(t *testing.T) {
 
 // BenchmarkSentimentAnalysis benchmarks sentiment analysis performance
 func BenchmarkSentimentAnalysis(b *testing.B) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		b.Skipf("Skipping benchmark - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(b)
 
 	ctx := context.Background()
 	text := "این محصول عالی است و من خیلی راضی هستم"
@@ -277,12 +391,7 @@ func BenchmarkSentimentAnalysis(b *testing.B) {
 
 // BenchmarkSentimentAnalysisWithRetry benchmarks sentiment analysis with retry
 func BenchmarkSentimentAnalysisWithRetry(b *testing.B) {
-	client, err := go_sdk.NewClient("localhost:50051")
-	if err != nil {
-		b.Skipf("Skipping benchmark - cannot connect to server: %v", err)
-		return
-	}
-	defer client.Close()
+	client := newTestClient(b)
 
 	ctx := context.Background()
 	text := "کیفیت بسیار بد بود و اصلا توصیه نمی‌کنم"
@@ -21,6 +21,292 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// SentimentLabel is the typed counterpart of SentimentResponse.label.
+type SentimentLabel int32
+
+const (
+	SentimentLabel_SENTIMENT_LABEL_UNSPECIFIED SentimentLabel = 0
+	SentimentLabel_SENTIMENT_LABEL_POSITIVE    SentimentLabel = 1
+	SentimentLabel_SENTIMENT_LABEL_NEGATIVE    SentimentLabel = 2
+	SentimentLabel_SENTIMENT_LABEL_NEUTRAL     SentimentLabel = 3
+	SentimentLabel_SENTIMENT_LABEL_MIXED       SentimentLabel = 4
+)
+
+// Enum value maps for SentimentLabel.
+var (
+	SentimentLabel_name = map[int32]string{
+		0: "SENTIMENT_LABEL_UNSPECIFIED",
+		1: "SENTIMENT_LABEL_POSITIVE",
+		2: "SENTIMENT_LABEL_NEGATIVE",
+		3: "SENTIMENT_LABEL_NEUTRAL",
+		4: "SENTIMENT_LABEL_MIXED",
+	}
+	SentimentLabel_value = map[string]int32{
+		"SENTIMENT_LABEL_UNSPECIFIED": 0,
+		"SENTIMENT_LABEL_POSITIVE":    1,
+		"SENTIMENT_LABEL_NEGATIVE":    2,
+		"SENTIMENT_LABEL_NEUTRAL":     3,
+		"SENTIMENT_LABEL_MIXED":       4,
+	}
+)
+
+func (x SentimentLabel) Enum() *SentimentLabel {
+	p := new(SentimentLabel)
+	*p = x
+	return p
+}
+
+func (x SentimentLabel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SentimentLabel) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_nlp_proto_enumTypes[0].Descriptor()
+}
+
+func (SentimentLabel) Type() protoreflect.EnumType {
+	return &file_api_nlp_proto_enumTypes[0]
+}
+
+func (x SentimentLabel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SentimentLabel.Descriptor instead.
+func (SentimentLabel) EnumDescriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{0}
+}
+
+type TokenKind int32
+
+const (
+	TokenKind_TOKEN_KIND_UNSPECIFIED TokenKind = 0
+	TokenKind_TOKEN_KIND_WORD        TokenKind = 1
+	TokenKind_TOKEN_KIND_NUMBER      TokenKind = 2
+	TokenKind_TOKEN_KIND_PUNCTUATION TokenKind = 3
+	TokenKind_TOKEN_KIND_SYMBOL      TokenKind = 4
+	TokenKind_TOKEN_KIND_EMOJI       TokenKind = 5
+	TokenKind_TOKEN_KIND_URL         TokenKind = 6
+	TokenKind_TOKEN_KIND_EMAIL       TokenKind = 7
+	TokenKind_TOKEN_KIND_HASHTAG     TokenKind = 8
+	TokenKind_TOKEN_KIND_MENTION     TokenKind = 9
+)
+
+// Enum value maps for TokenKind.
+var (
+	TokenKind_name = map[int32]string{
+		0: "TOKEN_KIND_UNSPECIFIED",
+		1: "TOKEN_KIND_WORD",
+		2: "TOKEN_KIND_NUMBER",
+		3: "TOKEN_KIND_PUNCTUATION",
+		4: "TOKEN_KIND_SYMBOL",
+		5: "TOKEN_KIND_EMOJI",
+		6: "TOKEN_KIND_URL",
+		7: "TOKEN_KIND_EMAIL",
+		8: "TOKEN_KIND_HASHTAG",
+		9: "TOKEN_KIND_MENTION",
+	}
+	TokenKind_value = map[string]int32{
+		"TOKEN_KIND_UNSPECIFIED": 0,
+		"TOKEN_KIND_WORD":        1,
+		"TOKEN_KIND_NUMBER":      2,
+		"TOKEN_KIND_PUNCTUATION": 3,
+		"TOKEN_KIND_SYMBOL":      4,
+		"TOKEN_KIND_EMOJI":       5,
+		"TOKEN_KIND_URL":         6,
+		"TOKEN_KIND_EMAIL":       7,
+		"TOKEN_KIND_HASHTAG":     8,
+		"TOKEN_KIND_MENTION":     9,
+	}
+)
+
+func (x TokenKind) Enum() *TokenKind {
+	p := new(TokenKind)
+	*p = x
+	return p
+}
+
+func (x TokenKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TokenKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_nlp_proto_enumTypes[1].Descriptor()
+}
+
+func (TokenKind) Type() protoreflect.EnumType {
+	return &file_api_nlp_proto_enumTypes[1]
+}
+
+func (x TokenKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TokenKind.Descriptor instead.
+func (TokenKind) EnumDescriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{1}
+}
+
+type EntityType int32
+
+const (
+	EntityType_ENTITY_TYPE_UNSPECIFIED EntityType = 0
+	// Person
+	EntityType_ENTITY_TYPE_PER EntityType = 1
+	// Location, e.g. a city or street
+	EntityType_ENTITY_TYPE_LOC EntityType = 2
+	// Organization, e.g. a company or restaurant
+	EntityType_ENTITY_TYPE_ORG     EntityType = 3
+	EntityType_ENTITY_TYPE_PRODUCT EntityType = 4
+	EntityType_ENTITY_TYPE_DATE    EntityType = 5
+	EntityType_ENTITY_TYPE_MONEY   EntityType = 6
+)
+
+// Enum value maps for EntityType.
+var (
+	EntityType_name = map[int32]string{
+		0: "ENTITY_TYPE_UNSPECIFIED",
+		1: "ENTITY_TYPE_PER",
+		2: "ENTITY_TYPE_LOC",
+		3: "ENTITY_TYPE_ORG",
+		4: "ENTITY_TYPE_PRODUCT",
+		5: "ENTITY_TYPE_DATE",
+		6: "ENTITY_TYPE_MONEY",
+	}
+	EntityType_value = map[string]int32{
+		"ENTITY_TYPE_UNSPECIFIED": 0,
+		"ENTITY_TYPE_PER":         1,
+		"ENTITY_TYPE_LOC":         2,
+		"ENTITY_TYPE_ORG":         3,
+		"ENTITY_TYPE_PRODUCT":     4,
+		"ENTITY_TYPE_DATE":        5,
+		"ENTITY_TYPE_MONEY":       6,
+	}
+)
+
+func (x EntityType) Enum() *EntityType {
+	p := new(EntityType)
+	*p = x
+	return p
+}
+
+func (x EntityType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EntityType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_nlp_proto_enumTypes[2].Descriptor()
+}
+
+func (EntityType) Type() protoreflect.EnumType {
+	return &file_api_nlp_proto_enumTypes[2]
+}
+
+func (x EntityType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EntityType.Descriptor instead.
+func (EntityType) EnumDescriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{2}
+}
+
+// PartOfSpeech is a Universal Dependencies part-of-speech tag
+type PartOfSpeech int32
+
+const (
+	PartOfSpeech_PART_OF_SPEECH_UNSPECIFIED PartOfSpeech = 0
+	PartOfSpeech_PART_OF_SPEECH_ADJ         PartOfSpeech = 1
+	PartOfSpeech_PART_OF_SPEECH_ADP         PartOfSpeech = 2
+	PartOfSpeech_PART_OF_SPEECH_ADV         PartOfSpeech = 3
+	PartOfSpeech_PART_OF_SPEECH_AUX         PartOfSpeech = 4
+	PartOfSpeech_PART_OF_SPEECH_CCONJ       PartOfSpeech = 5
+	PartOfSpeech_PART_OF_SPEECH_DET         PartOfSpeech = 6
+	PartOfSpeech_PART_OF_SPEECH_INTJ        PartOfSpeech = 7
+	PartOfSpeech_PART_OF_SPEECH_NOUN        PartOfSpeech = 8
+	PartOfSpeech_PART_OF_SPEECH_NUM         PartOfSpeech = 9
+	PartOfSpeech_PART_OF_SPEECH_PART        PartOfSpeech = 10
+	PartOfSpeech_PART_OF_SPEECH_PRON        PartOfSpeech = 11
+	PartOfSpeech_PART_OF_SPEECH_PROPN       PartOfSpeech = 12
+	PartOfSpeech_PART_OF_SPEECH_PUNCT       PartOfSpeech = 13
+	PartOfSpeech_PART_OF_SPEECH_SCONJ       PartOfSpeech = 14
+	PartOfSpeech_PART_OF_SPEECH_SYM         PartOfSpeech = 15
+	PartOfSpeech_PART_OF_SPEECH_VERB        PartOfSpeech = 16
+	// Other, e.g. URLs and foreign words
+	PartOfSpeech_PART_OF_SPEECH_X PartOfSpeech = 17
+)
+
+// Enum value maps for PartOfSpeech.
+var (
+	PartOfSpeech_name = map[int32]string{
+		0:  "PART_OF_SPEECH_UNSPECIFIED",
+		1:  "PART_OF_SPEECH_ADJ",
+		2:  "PART_OF_SPEECH_ADP",
+		3:  "PART_OF_SPEECH_ADV",
+		4:  "PART_OF_SPEECH_AUX",
+		5:  "PART_OF_SPEECH_CCONJ",
+		6:  "PART_OF_SPEECH_DET",
+		7:  "PART_OF_SPEECH_INTJ",
+		8:  "PART_OF_SPEECH_NOUN",
+		9:  "PART_OF_SPEECH_NUM",
+		10: "PART_OF_SPEECH_PART",
+		11: "PART_OF_SPEECH_PRON",
+		12: "PART_OF_SPEECH_PROPN",
+		13: "PART_OF_SPEECH_PUNCT",
+		14: "PART_OF_SPEECH_SCONJ",
+		15: "PART_OF_SPEECH_SYM",
+		16: "PART_OF_SPEECH_VERB",
+		17: "PART_OF_SPEECH_X",
+	}
+	PartOfSpeech_value = map[string]int32{
+		"PART_OF_SPEECH_UNSPECIFIED": 0,
+		"PART_OF_SPEECH_ADJ":         1,
+		"PART_OF_SPEECH_ADP":         2,
+		"PART_OF_SPEECH_ADV":         3,
+		"PART_OF_SPEECH_AUX":         4,
+		"PART_OF_SPEECH_CCONJ":       5,
+		"PART_OF_SPEECH_DET":         6,
+		"PART_OF_SPEECH_INTJ":        7,
+		"PART_OF_SPEECH_NOUN":        8,
+		"PART_OF_SPEECH_NUM":         9,
+		"PART_OF_SPEECH_PART":        10,
+		"PART_OF_SPEECH_PRON":        11,
+		"PART_OF_SPEECH_PROPN":       12,
+		"PART_OF_SPEECH_PUNCT":       13,
+		"PART_OF_SPEECH_SCONJ":       14,
+		"PART_OF_SPEECH_SYM":         15,
+		"PART_OF_SPEECH_VERB":        16,
+		"PART_OF_SPEECH_X":           17,
+	}
+)
+
+func (x PartOfSpeech) Enum() *PartOfSpeech {
+	p := new(PartOfSpeech)
+	*p = x
+	return p
+}
+
+func (x PartOfSpeech) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartOfSpeech) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_nlp_proto_enumTypes[3].Descriptor()
+}
+
+func (PartOfSpeech) Type() protoreflect.EnumType {
+	return &file_api_nlp_proto_enumTypes[3]
+}
+
+func (x PartOfSpeech) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartOfSpeech.Descriptor instead.
+func (PartOfSpeech) EnumDescriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{3}
+}
+
 type SentimentRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
@@ -74,9 +360,15 @@ func (x *SentimentRequest) GetLang() string {
 }
 
 type SentimentResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Label         string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Label     string                 `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Score     float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	Sentiment SentimentLabel         `protobuf:"varint,3,opt,name=sentiment,proto3,enum=nlp.SentimentLabel" json:"sentiment,omitempty"`
+	// Probability of every class the model knows, keyed by lower-case label name.
+	Probabilities map[string]float64 `protobuf:"bytes,4,rep,name=probabilities,proto3" json:"probabilities,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Identifies the model that produced the response, so cached results can be
+	// invalidated when the engine is upgraded.
+	ModelVersion  string `protobuf:"bytes,5,opt,name=model_version,json=modelVersion,proto3" json:"model_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,65 +417,1735 @@ func (x *SentimentResponse) GetScore() float64 {
 	return 0
 }
 
-var File_api_nlp_proto protoreflect.FileDescriptor
+func (x *SentimentResponse) GetSentiment() SentimentLabel {
+	if x != nil {
+		return x.Sentiment
+	}
+	return SentimentLabel_SENTIMENT_LABEL_UNSPECIFIED
+}
 
-const file_api_nlp_proto_rawDesc = "" +
-	"\n" +
-	"\rapi/nlp.proto\x12\x03nlp\":\n" +
-	"\x10SentimentRequest\x12\x12\n" +
-	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
-	"\x04lang\x18\x02 \x01(\tR\x04lang\"?\n" +
-	"\x11SentimentResponse\x12\x14\n" +
-	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
-	"\x05score\x18\x02 \x01(\x01R\x05score2O\n" +
-	"\n" +
-	"NLPManager\x12A\n" +
-	"\x10AnalyzeSentiment\x12\x15.nlp.SentimentRequest\x1a\x16.nlp.SentimentResponseB\x1fZ\x1dgithub.com/Mannymz/ZenNLP/apib\x06proto3"
+func (x *SentimentResponse) GetProbabilities() map[string]float64 {
+	if x != nil {
+		return x.Probabilities
+	}
+	return nil
+}
 
-var (
-	file_api_nlp_proto_rawDescOnce sync.Once
-	file_api_nlp_proto_rawDescData []byte
-)
+func (x *SentimentResponse) GetModelVersion() string {
+	if x != nil {
+		return x.ModelVersion
+	}
+	return ""
+}
 
-func file_api_nlp_proto_rawDescGZIP() []byte {
-	file_api_nlp_proto_rawDescOnce.Do(func() {
-		file_api_nlp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_nlp_proto_rawDesc), len(file_api_nlp_proto_rawDesc)))
-	})
-	return file_api_nlp_proto_rawDescData
+// SentimentBatchRequest carries several texts, each with its own language.
+type SentimentBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*SentimentRequest    `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-var file_api_nlp_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_api_nlp_proto_goTypes = []any{
-	(*SentimentRequest)(nil),  // 0: nlp.SentimentRequest
-	(*SentimentResponse)(nil), // 1: nlp.SentimentResponse
+func (x *SentimentBatchRequest) Reset() {
+	*x = SentimentBatchRequest{}
+	mi := &file_api_nlp_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
-var file_api_nlp_proto_depIdxs = []int32{
-	0, // 0: nlp.NLPManager.AnalyzeSentiment:input_type -> nlp.SentimentRequest
-	1, // 1: nlp.NLPManager.AnalyzeSentiment:output_type -> nlp.SentimentResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *SentimentBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_api_nlp_proto_init() }
-func file_api_nlp_proto_init() {
-	if File_api_nlp_proto != nil {
-		return
+func (*SentimentBatchRequest) ProtoMessage() {}
+
+func (x *SentimentBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentBatchRequest.ProtoReflect.Descriptor instead.
+func (*SentimentBatchRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SentimentBatchRequest) GetItems() []*SentimentRequest {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// SentimentBatchResponse holds one result per request item, in request order.
+type SentimentBatchResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*SentimentBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentBatchResponse) Reset() {
+	*x = SentimentBatchResponse{}
+	mi := &file_api_nlp_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentBatchResponse) ProtoMessage() {}
+
+func (x *SentimentBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentBatchResponse.ProtoReflect.Descriptor instead.
+func (*SentimentBatchResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SentimentBatchResponse) GetResults() []*SentimentBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SentimentBatchResult is either a response or the error for a single item.
+type SentimentBatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Index int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*SentimentBatchResult_Response
+	//	*SentimentBatchResult_Error
+	Outcome       isSentimentBatchResult_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentBatchResult) Reset() {
+	*x = SentimentBatchResult{}
+	mi := &file_api_nlp_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentBatchResult) ProtoMessage() {}
+
+func (x *SentimentBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentBatchResult.ProtoReflect.Descriptor instead.
+func (*SentimentBatchResult) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SentimentBatchResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *SentimentBatchResult) GetOutcome() isSentimentBatchResult_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *SentimentBatchResult) GetResponse() *SentimentResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*SentimentBatchResult_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *SentimentBatchResult) GetError() *ItemError {
+	if x != nil {
+		if x, ok := x.Outcome.(*SentimentBatchResult_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isSentimentBatchResult_Outcome interface {
+	isSentimentBatchResult_Outcome()
+}
+
+type SentimentBatchResult_Response struct {
+	Response *SentimentResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type SentimentBatchResult_Error struct {
+	Error *ItemError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*SentimentBatchResult_Response) isSentimentBatchResult_Outcome() {}
+
+func (*SentimentBatchResult_Error) isSentimentBatchResult_Outcome() {}
+
+// ItemError describes a failure for one batch or stream item using gRPC status codes.
+type ItemError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          int32                  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ItemError) Reset() {
+	*x = ItemError{}
+	mi := &file_api_nlp_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ItemError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemError) ProtoMessage() {}
+
+func (x *ItemError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemError.ProtoReflect.Descriptor instead.
+func (*ItemError) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ItemError) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+func (x *ItemError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SentimentStreamRequest is a single text sent on a sentiment stream.
+// The id is chosen by the client and echoed back on the matching response.
+type SentimentStreamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Request       *SentimentRequest      `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentStreamRequest) Reset() {
+	*x = SentimentStreamRequest{}
+	mi := &file_api_nlp_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentStreamRequest) ProtoMessage() {}
+
+func (x *SentimentStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentStreamRequest.ProtoReflect.Descriptor instead.
+func (*SentimentStreamRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SentimentStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SentimentStreamRequest) GetRequest() *SentimentRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// SentimentStreamResponse is the result for the stream request with the same id.
+type SentimentStreamResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*SentimentStreamResponse_Response
+	//	*SentimentStreamResponse_Error
+	Outcome       isSentimentStreamResponse_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SentimentStreamResponse) Reset() {
+	*x = SentimentStreamResponse{}
+	mi := &file_api_nlp_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SentimentStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentimentStreamResponse) ProtoMessage() {}
+
+func (x *SentimentStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentimentStreamResponse.ProtoReflect.Descriptor instead.
+func (*SentimentStreamResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SentimentStreamResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *SentimentStreamResponse) GetOutcome() isSentimentStreamResponse_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *SentimentStreamResponse) GetResponse() *SentimentResponse {
+	if x != nil {
+		if x, ok := x.Outcome.(*SentimentStreamResponse_Response); ok {
+			return x.Response
+		}
+	}
+	return nil
+}
+
+func (x *SentimentStreamResponse) GetError() *ItemError {
+	if x != nil {
+		if x, ok := x.Outcome.(*SentimentStreamResponse_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isSentimentStreamResponse_Outcome interface {
+	isSentimentStreamResponse_Outcome()
+}
+
+type SentimentStreamResponse_Response struct {
+	Response *SentimentResponse `protobuf:"bytes,2,opt,name=response,proto3,oneof"`
+}
+
+type SentimentStreamResponse_Error struct {
+	Error *ItemError `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*SentimentStreamResponse_Response) isSentimentStreamResponse_Outcome() {}
+
+func (*SentimentStreamResponse_Error) isSentimentStreamResponse_Outcome() {}
+
+type LemmatizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LemmatizeRequest) Reset() {
+	*x = LemmatizeRequest{}
+	mi := &file_api_nlp_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LemmatizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LemmatizeRequest) ProtoMessage() {}
+
+func (x *LemmatizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LemmatizeRequest.ProtoReflect.Descriptor instead.
+func (*LemmatizeRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *LemmatizeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *LemmatizeRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// Lemma is a word of the text with its stem and dictionary form. Offsets count
+// Unicode code points, end exclusive.
+type Lemma struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Word          string                 `protobuf:"bytes,1,opt,name=word,proto3" json:"word,omitempty"`
+	Stem          string                 `protobuf:"bytes,2,opt,name=stem,proto3" json:"stem,omitempty"`
+	Lemma         string                 `protobuf:"bytes,3,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	Start         int32                  `protobuf:"varint,4,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,5,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Lemma) Reset() {
+	*x = Lemma{}
+	mi := &file_api_nlp_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Lemma) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lemma) ProtoMessage() {}
+
+func (x *Lemma) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lemma.ProtoReflect.Descriptor instead.
+func (*Lemma) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Lemma) GetWord() string {
+	if x != nil {
+		return x.Word
+	}
+	return ""
+}
+
+func (x *Lemma) GetStem() string {
+	if x != nil {
+		return x.Stem
+	}
+	return ""
+}
+
+func (x *Lemma) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+func (x *Lemma) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Lemma) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// LemmatizeResponse lists the words of the text in order. Numbers, punctuation
+// and other tokens are left out.
+type LemmatizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lemmas        []*Lemma               `protobuf:"bytes,1,rep,name=lemmas,proto3" json:"lemmas,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LemmatizeResponse) Reset() {
+	*x = LemmatizeResponse{}
+	mi := &file_api_nlp_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LemmatizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LemmatizeResponse) ProtoMessage() {}
+
+func (x *LemmatizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LemmatizeResponse.ProtoReflect.Descriptor instead.
+func (*LemmatizeResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LemmatizeResponse) GetLemmas() []*Lemma {
+	if x != nil {
+		return x.Lemmas
+	}
+	return nil
+}
+
+type TokenizeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeRequest) Reset() {
+	*x = TokenizeRequest{}
+	mi := &file_api_nlp_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeRequest) ProtoMessage() {}
+
+func (x *TokenizeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeRequest.ProtoReflect.Descriptor instead.
+func (*TokenizeRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *TokenizeRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TokenizeRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// Token is a span of the text. Offsets count Unicode code points, end exclusive.
+type Token struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Kind          TokenKind              `protobuf:"varint,2,opt,name=kind,proto3,enum=nlp.TokenKind" json:"kind,omitempty"`
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Token) Reset() {
+	*x = Token{}
+	mi := &file_api_nlp_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Token) ProtoMessage() {}
+
+func (x *Token) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Token.ProtoReflect.Descriptor instead.
+func (*Token) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Token) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Token) GetKind() TokenKind {
+	if x != nil {
+		return x.Kind
+	}
+	return TokenKind_TOKEN_KIND_UNSPECIFIED
+}
+
+func (x *Token) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Token) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+// TokenizeResponse lists the tokens of the text in order, without whitespace.
+type TokenizeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*Token               `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenizeResponse) Reset() {
+	*x = TokenizeResponse{}
+	mi := &file_api_nlp_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenizeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenizeResponse) ProtoMessage() {}
+
+func (x *TokenizeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenizeResponse.ProtoReflect.Descriptor instead.
+func (*TokenizeResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *TokenizeResponse) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type SegmentSentencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentSentencesRequest) Reset() {
+	*x = SegmentSentencesRequest{}
+	mi := &file_api_nlp_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentSentencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentSentencesRequest) ProtoMessage() {}
+
+func (x *SegmentSentencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentSentencesRequest.ProtoReflect.Descriptor instead.
+func (*SegmentSentencesRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SegmentSentencesRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *SegmentSentencesRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// Sentence is a sentence of the text with its tokens. Offsets count Unicode
+// code points, end exclusive.
+type Sentence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Tokens        []*Token               `protobuf:"bytes,2,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Sentence) Reset() {
+	*x = Sentence{}
+	mi := &file_api_nlp_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Sentence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Sentence) ProtoMessage() {}
+
+func (x *Sentence) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Sentence.ProtoReflect.Descriptor instead.
+func (*Sentence) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Sentence) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Sentence) GetTokens() []*Token {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (x *Sentence) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Sentence) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+type SegmentSentencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sentences     []*Sentence            `protobuf:"bytes,1,rep,name=sentences,proto3" json:"sentences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SegmentSentencesResponse) Reset() {
+	*x = SegmentSentencesResponse{}
+	mi := &file_api_nlp_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SegmentSentencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SegmentSentencesResponse) ProtoMessage() {}
+
+func (x *SegmentSentencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SegmentSentencesResponse.ProtoReflect.Descriptor instead.
+func (*SegmentSentencesResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SegmentSentencesResponse) GetSentences() []*Sentence {
+	if x != nil {
+		return x.Sentences
+	}
+	return nil
+}
+
+type ExtractEntitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractEntitiesRequest) Reset() {
+	*x = ExtractEntitiesRequest{}
+	mi := &file_api_nlp_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractEntitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractEntitiesRequest) ProtoMessage() {}
+
+func (x *ExtractEntitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractEntitiesRequest.ProtoReflect.Descriptor instead.
+func (*ExtractEntitiesRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExtractEntitiesRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ExtractEntitiesRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// Entity is a named entity found in the text. Offsets count Unicode code
+// points, end exclusive.
+type Entity struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Type          EntityType             `protobuf:"varint,2,opt,name=type,proto3,enum=nlp.EntityType" json:"type,omitempty"`
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Confidence    float64                `protobuf:"fixed64,5,opt,name=confidence,proto3" json:"confidence,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Entity) Reset() {
+	*x = Entity{}
+	mi := &file_api_nlp_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Entity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Entity) ProtoMessage() {}
+
+func (x *Entity) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Entity.ProtoReflect.Descriptor instead.
+func (*Entity) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Entity) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Entity) GetType() EntityType {
+	if x != nil {
+		return x.Type
+	}
+	return EntityType_ENTITY_TYPE_UNSPECIFIED
+}
+
+func (x *Entity) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Entity) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Entity) GetConfidence() float64 {
+	if x != nil {
+		return x.Confidence
+	}
+	return 0
+}
+
+// ExtractEntitiesResponse lists the entities of the text in order. Entities do
+// not overlap.
+type ExtractEntitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entities      []*Entity              `protobuf:"bytes,1,rep,name=entities,proto3" json:"entities,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtractEntitiesResponse) Reset() {
+	*x = ExtractEntitiesResponse{}
+	mi := &file_api_nlp_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtractEntitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtractEntitiesResponse) ProtoMessage() {}
+
+func (x *ExtractEntitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtractEntitiesResponse.ProtoReflect.Descriptor instead.
+func (*ExtractEntitiesResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ExtractEntitiesResponse) GetEntities() []*Entity {
+	if x != nil {
+		return x.Entities
+	}
+	return nil
+}
+
+type TagPartsOfSpeechRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagPartsOfSpeechRequest) Reset() {
+	*x = TagPartsOfSpeechRequest{}
+	mi := &file_api_nlp_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagPartsOfSpeechRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagPartsOfSpeechRequest) ProtoMessage() {}
+
+func (x *TagPartsOfSpeechRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagPartsOfSpeechRequest.ProtoReflect.Descriptor instead.
+func (*TagPartsOfSpeechRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TagPartsOfSpeechRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TagPartsOfSpeechRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// TaggedToken is a token of the text with its part of speech. Offsets count
+// Unicode code points, end exclusive.
+type TaggedToken struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Tag           PartOfSpeech           `protobuf:"varint,2,opt,name=tag,proto3,enum=nlp.PartOfSpeech" json:"tag,omitempty"`
+	Start         int32                  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32                  `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Lemma         string                 `protobuf:"bytes,5,opt,name=lemma,proto3" json:"lemma,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TaggedToken) Reset() {
+	*x = TaggedToken{}
+	mi := &file_api_nlp_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TaggedToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TaggedToken) ProtoMessage() {}
+
+func (x *TaggedToken) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TaggedToken.ProtoReflect.Descriptor instead.
+func (*TaggedToken) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TaggedToken) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *TaggedToken) GetTag() PartOfSpeech {
+	if x != nil {
+		return x.Tag
+	}
+	return PartOfSpeech_PART_OF_SPEECH_UNSPECIFIED
+}
+
+func (x *TaggedToken) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *TaggedToken) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *TaggedToken) GetLemma() string {
+	if x != nil {
+		return x.Lemma
+	}
+	return ""
+}
+
+// TagPartsOfSpeechResponse lists the tokens of the text in order, without
+// whitespace.
+type TagPartsOfSpeechResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tokens        []*TaggedToken         `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TagPartsOfSpeechResponse) Reset() {
+	*x = TagPartsOfSpeechResponse{}
+	mi := &file_api_nlp_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TagPartsOfSpeechResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TagPartsOfSpeechResponse) ProtoMessage() {}
+
+func (x *TagPartsOfSpeechResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TagPartsOfSpeechResponse.ProtoReflect.Descriptor instead.
+func (*TagPartsOfSpeechResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TagPartsOfSpeechResponse) GetTokens() []*TaggedToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+type AnalyzeAspectsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Text          string                 `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Lang          string                 `protobuf:"bytes,2,opt,name=lang,proto3" json:"lang,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeAspectsRequest) Reset() {
+	*x = AnalyzeAspectsRequest{}
+	mi := &file_api_nlp_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeAspectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeAspectsRequest) ProtoMessage() {}
+
+func (x *AnalyzeAspectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeAspectsRequest.ProtoReflect.Descriptor instead.
+func (*AnalyzeAspectsRequest) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AnalyzeAspectsRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *AnalyzeAspectsRequest) GetLang() string {
+	if x != nil {
+		return x.Lang
+	}
+	return ""
+}
+
+// Aspect is the sentiment the text expresses about one aspect term, e.g. پیک
+// of the category delivery. Offsets count Unicode code points, end exclusive.
+type Aspect struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Term  string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	// Category of the term in the aspect taxonomy of the server, e.g. food
+	Category      string         `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
+	Start         int32          `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	End           int32          `protobuf:"varint,4,opt,name=end,proto3" json:"end,omitempty"`
+	Label         SentimentLabel `protobuf:"varint,5,opt,name=label,proto3,enum=nlp.SentimentLabel" json:"label,omitempty"`
+	Score         float64        `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Aspect) Reset() {
+	*x = Aspect{}
+	mi := &file_api_nlp_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Aspect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aspect) ProtoMessage() {}
+
+func (x *Aspect) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aspect.ProtoReflect.Descriptor instead.
+func (*Aspect) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Aspect) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *Aspect) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Aspect) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Aspect) GetEnd() int32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+func (x *Aspect) GetLabel() SentimentLabel {
+	if x != nil {
+		return x.Label
+	}
+	return SentimentLabel_SENTIMENT_LABEL_UNSPECIFIED
+}
+
+func (x *Aspect) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// AnalyzeAspectsResponse lists the aspect terms of the text in order.
+// Aspects the text holds no opinion about are neutral.
+type AnalyzeAspectsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Aspects       []*Aspect              `protobuf:"bytes,1,rep,name=aspects,proto3" json:"aspects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AnalyzeAspectsResponse) Reset() {
+	*x = AnalyzeAspectsResponse{}
+	mi := &file_api_nlp_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AnalyzeAspectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AnalyzeAspectsResponse) ProtoMessage() {}
+
+func (x *AnalyzeAspectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_nlp_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AnalyzeAspectsResponse.ProtoReflect.Descriptor instead.
+func (*AnalyzeAspectsResponse) Descriptor() ([]byte, []int) {
+	return file_api_nlp_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AnalyzeAspectsResponse) GetAspects() []*Aspect {
+	if x != nil {
+		return x.Aspects
+	}
+	return nil
+}
+
+var File_api_nlp_proto protoreflect.FileDescriptor
+
+const file_api_nlp_proto_rawDesc = "" +
+	"\n" +
+	"\rapi/nlp.proto\x12\x03nlp\":\n" +
+	"\x10SentimentRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"\xaa\x02\n" +
+	"\x11SentimentResponse\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\x121\n" +
+	"\tsentiment\x18\x03 \x01(\x0e2\x13.nlp.SentimentLabelR\tsentiment\x12O\n" +
+	"\rprobabilities\x18\x04 \x03(\v2).nlp.SentimentResponse.ProbabilitiesEntryR\rprobabilities\x12#\n" +
+	"\rmodel_version\x18\x05 \x01(\tR\fmodelVersion\x1a@\n" +
+	"\x12ProbabilitiesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"D\n" +
+	"\x15SentimentBatchRequest\x12+\n" +
+	"\x05items\x18\x01 \x03(\v2\x15.nlp.SentimentRequestR\x05items\"M\n" +
+	"\x16SentimentBatchResponse\x123\n" +
+	"\aresults\x18\x01 \x03(\v2\x19.nlp.SentimentBatchResultR\aresults\"\x95\x01\n" +
+	"\x14SentimentBatchResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x124\n" +
+	"\bresponse\x18\x02 \x01(\v2\x16.nlp.SentimentResponseH\x00R\bresponse\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x0e.nlp.ItemErrorH\x00R\x05errorB\t\n" +
+	"\aoutcome\"9\n" +
+	"\tItemError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\x05R\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"Y\n" +
+	"\x16SentimentStreamRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12/\n" +
+	"\arequest\x18\x02 \x01(\v2\x15.nlp.SentimentRequestR\arequest\"\x92\x01\n" +
+	"\x17SentimentStreamResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x124\n" +
+	"\bresponse\x18\x02 \x01(\v2\x16.nlp.SentimentResponseH\x00R\bresponse\x12&\n" +
+	"\x05error\x18\x03 \x01(\v2\x0e.nlp.ItemErrorH\x00R\x05errorB\t\n" +
+	"\aoutcome\":\n" +
+	"\x10LemmatizeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"m\n" +
+	"\x05Lemma\x12\x12\n" +
+	"\x04word\x18\x01 \x01(\tR\x04word\x12\x12\n" +
+	"\x04stem\x18\x02 \x01(\tR\x04stem\x12\x14\n" +
+	"\x05lemma\x18\x03 \x01(\tR\x05lemma\x12\x14\n" +
+	"\x05start\x18\x04 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x05 \x01(\x05R\x03end\"7\n" +
+	"\x11LemmatizeResponse\x12\"\n" +
+	"\x06lemmas\x18\x01 \x03(\v2\n" +
+	".nlp.LemmaR\x06lemmas\"9\n" +
+	"\x0fTokenizeRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"g\n" +
+	"\x05Token\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\"\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x0e.nlp.TokenKindR\x04kind\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\"6\n" +
+	"\x10TokenizeResponse\x12\"\n" +
+	"\x06tokens\x18\x01 \x03(\v2\n" +
+	".nlp.TokenR\x06tokens\"A\n" +
+	"\x17SegmentSentencesRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"j\n" +
+	"\bSentence\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\"\n" +
+	"\x06tokens\x18\x02 \x03(\v2\n" +
+	".nlp.TokenR\x06tokens\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\"G\n" +
+	"\x18SegmentSentencesResponse\x12+\n" +
+	"\tsentences\x18\x01 \x03(\v2\r.nlp.SentenceR\tsentences\"@\n" +
+	"\x16ExtractEntitiesRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"\x89\x01\n" +
+	"\x06Entity\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12#\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x0f.nlp.EntityTypeR\x04type\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\x12\x1e\n" +
+	"\n" +
+	"confidence\x18\x05 \x01(\x01R\n" +
+	"confidence\"B\n" +
+	"\x17ExtractEntitiesResponse\x12'\n" +
+	"\bentities\x18\x01 \x03(\v2\v.nlp.EntityR\bentities\"A\n" +
+	"\x17TagPartsOfSpeechRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"\x84\x01\n" +
+	"\vTaggedToken\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12#\n" +
+	"\x03tag\x18\x02 \x01(\x0e2\x11.nlp.PartOfSpeechR\x03tag\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\x12\x14\n" +
+	"\x05lemma\x18\x05 \x01(\tR\x05lemma\"D\n" +
+	"\x18TagPartsOfSpeechResponse\x12(\n" +
+	"\x06tokens\x18\x01 \x03(\v2\x10.nlp.TaggedTokenR\x06tokens\"?\n" +
+	"\x15AnalyzeAspectsRequest\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x12\x12\n" +
+	"\x04lang\x18\x02 \x01(\tR\x04lang\"\xa1\x01\n" +
+	"\x06Aspect\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x1a\n" +
+	"\bcategory\x18\x02 \x01(\tR\bcategory\x12\x14\n" +
+	"\x05start\x18\x03 \x01(\x05R\x05start\x12\x10\n" +
+	"\x03end\x18\x04 \x01(\x05R\x03end\x12)\n" +
+	"\x05label\x18\x05 \x01(\x0e2\x13.nlp.SentimentLabelR\x05label\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\"?\n" +
+	"\x16AnalyzeAspectsResponse\x12%\n" +
+	"\aaspects\x18\x01 \x03(\v2\v.nlp.AspectR\aaspects*\xa5\x01\n" +
+	"\x0eSentimentLabel\x12\x1f\n" +
+	"\x1bSENTIMENT_LABEL_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SENTIMENT_LABEL_POSITIVE\x10\x01\x12\x1c\n" +
+	"\x18SENTIMENT_LABEL_NEGATIVE\x10\x02\x12\x1b\n" +
+	"\x17SENTIMENT_LABEL_NEUTRAL\x10\x03\x12\x19\n" +
+	"\x15SENTIMENT_LABEL_MIXED\x10\x04*\xf6\x01\n" +
+	"\tTokenKind\x12\x1a\n" +
+	"\x16TOKEN_KIND_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTOKEN_KIND_WORD\x10\x01\x12\x15\n" +
+	"\x11TOKEN_KIND_NUMBER\x10\x02\x12\x1a\n" +
+	"\x16TOKEN_KIND_PUNCTUATION\x10\x03\x12\x15\n" +
+	"\x11TOKEN_KIND_SYMBOL\x10\x04\x12\x14\n" +
+	"\x10TOKEN_KIND_EMOJI\x10\x05\x12\x12\n" +
+	"\x0eTOKEN_KIND_URL\x10\x06\x12\x14\n" +
+	"\x10TOKEN_KIND_EMAIL\x10\a\x12\x16\n" +
+	"\x12TOKEN_KIND_HASHTAG\x10\b\x12\x16\n" +
+	"\x12TOKEN_KIND_MENTION\x10\t*\xae\x01\n" +
+	"\n" +
+	"EntityType\x12\x1b\n" +
+	"\x17ENTITY_TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fENTITY_TYPE_PER\x10\x01\x12\x13\n" +
+	"\x0fENTITY_TYPE_LOC\x10\x02\x12\x13\n" +
+	"\x0fENTITY_TYPE_ORG\x10\x03\x12\x17\n" +
+	"\x13ENTITY_TYPE_PRODUCT\x10\x04\x12\x14\n" +
+	"\x10ENTITY_TYPE_DATE\x10\x05\x12\x15\n" +
+	"\x11ENTITY_TYPE_MONEY\x10\x06*\xd1\x03\n" +
+	"\fPartOfSpeech\x12\x1e\n" +
+	"\x1aPART_OF_SPEECH_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12PART_OF_SPEECH_ADJ\x10\x01\x12\x16\n" +
+	"\x12PART_OF_SPEECH_ADP\x10\x02\x12\x16\n" +
+	"\x12PART_OF_SPEECH_ADV\x10\x03\x12\x16\n" +
+	"\x12PART_OF_SPEECH_AUX\x10\x04\x12\x18\n" +
+	"\x14PART_OF_SPEECH_CCONJ\x10\x05\x12\x16\n" +
+	"\x12PART_OF_SPEECH_DET\x10\x06\x12\x17\n" +
+	"\x13PART_OF_SPEECH_INTJ\x10\a\x12\x17\n" +
+	"\x13PART_OF_SPEECH_NOUN\x10\b\x12\x16\n" +
+	"\x12PART_OF_SPEECH_NUM\x10\t\x12\x17\n" +
+	"\x13PART_OF_SPEECH_PART\x10\n" +
+	"\x12\x17\n" +
+	"\x13PART_OF_SPEECH_PRON\x10\v\x12\x18\n" +
+	"\x14PART_OF_SPEECH_PROPN\x10\f\x12\x18\n" +
+	"\x14PART_OF_SPEECH_PUNCT\x10\r\x12\x18\n" +
+	"\x14PART_OF_SPEECH_SCONJ\x10\x0e\x12\x16\n" +
+	"\x12PART_OF_SPEECH_SYM\x10\x0f\x12\x17\n" +
+	"\x13PART_OF_SPEECH_VERB\x10\x10\x12\x14\n" +
+	"\x10PART_OF_SPEECH_X\x10\x112\xa3\x05\n" +
+	"\n" +
+	"NLPManager\x12A\n" +
+	"\x10AnalyzeSentiment\x12\x15.nlp.SentimentRequest\x1a\x16.nlp.SentimentResponse\x12P\n" +
+	"\x15AnalyzeSentimentBatch\x12\x1a.nlp.SentimentBatchRequest\x1a\x1b.nlp.SentimentBatchResponse\x12P\n" +
+	"\x0fStreamSentiment\x12\x1b.nlp.SentimentStreamRequest\x1a\x1c.nlp.SentimentStreamResponse(\x010\x01\x12:\n" +
+	"\tLemmatize\x12\x15.nlp.LemmatizeRequest\x1a\x16.nlp.LemmatizeResponse\x127\n" +
+	"\bTokenize\x12\x14.nlp.TokenizeRequest\x1a\x15.nlp.TokenizeResponse\x12O\n" +
+	"\x10SegmentSentences\x12\x1c.nlp.SegmentSentencesRequest\x1a\x1d.nlp.SegmentSentencesResponse\x12L\n" +
+	"\x0fExtractEntities\x12\x1b.nlp.ExtractEntitiesRequest\x1a\x1c.nlp.ExtractEntitiesResponse\x12O\n" +
+	"\x10TagPartsOfSpeech\x12\x1c.nlp.TagPartsOfSpeechRequest\x1a\x1d.nlp.TagPartsOfSpeechResponse\x12I\n" +
+	"\x0eAnalyzeAspects\x12\x1a.nlp.AnalyzeAspectsRequest\x1a\x1b.nlp.AnalyzeAspectsResponseB\x1fZ\x1dgithub.com/Mannymz/ZenNLP/apib\x06proto3"
+
+var (
+	file_api_nlp_proto_rawDescOnce sync.Once
+	file_api_nlp_proto_rawDescData []byte
+)
+
+func file_api_nlp_proto_rawDescGZIP() []byte {
+	file_api_nlp_proto_rawDescOnce.Do(func() {
+		file_api_nlp_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_nlp_proto_rawDesc), len(file_api_nlp_proto_rawDesc)))
+	})
+	return file_api_nlp_proto_rawDescData
+}
+
+var file_api_nlp_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_api_nlp_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_api_nlp_proto_goTypes = []any{
+	(SentimentLabel)(0),              // 0: nlp.SentimentLabel
+	(TokenKind)(0),                   // 1: nlp.TokenKind
+	(EntityType)(0),                  // 2: nlp.EntityType
+	(PartOfSpeech)(0),                // 3: nlp.PartOfSpeech
+	(*SentimentRequest)(nil),         // 4: nlp.SentimentRequest
+	(*SentimentResponse)(nil),        // 5: nlp.SentimentResponse
+	(*SentimentBatchRequest)(nil),    // 6: nlp.SentimentBatchRequest
+	(*SentimentBatchResponse)(nil),   // 7: nlp.SentimentBatchResponse
+	(*SentimentBatchResult)(nil),     // 8: nlp.SentimentBatchResult
+	(*ItemError)(nil),                // 9: nlp.ItemError
+	(*SentimentStreamRequest)(nil),   // 10: nlp.SentimentStreamRequest
+	(*SentimentStreamResponse)(nil),  // 11: nlp.SentimentStreamResponse
+	(*LemmatizeRequest)(nil),         // 12: nlp.LemmatizeRequest
+	(*Lemma)(nil),                    // 13: nlp.Lemma
+	(*LemmatizeResponse)(nil),        // 14: nlp.LemmatizeResponse
+	(*TokenizeRequest)(nil),          // 15: nlp.TokenizeRequest
+	(*Token)(nil),                    // 16: nlp.Token
+	(*TokenizeResponse)(nil),         // 17: nlp.TokenizeResponse
+	(*SegmentSentencesRequest)(nil),  // 18: nlp.SegmentSentencesRequest
+	(*Sentence)(nil),                 // 19: nlp.Sentence
+	(*SegmentSentencesResponse)(nil), // 20: nlp.SegmentSentencesResponse
+	(*ExtractEntitiesRequest)(nil),   // 21: nlp.ExtractEntitiesRequest
+	(*Entity)(nil),                   // 22: nlp.Entity
+	(*ExtractEntitiesResponse)(nil),  // 23: nlp.ExtractEntitiesResponse
+	(*TagPartsOfSpeechRequest)(nil),  // 24: nlp.TagPartsOfSpeechRequest
+	(*TaggedToken)(nil),              // 25: nlp.TaggedToken
+	(*TagPartsOfSpeechResponse)(nil), // 26: nlp.TagPartsOfSpeechResponse
+	(*AnalyzeAspectsRequest)(nil),    // 27: nlp.AnalyzeAspectsRequest
+	(*Aspect)(nil),                   // 28: nlp.Aspect
+	(*AnalyzeAspectsResponse)(nil),   // 29: nlp.AnalyzeAspectsResponse
+	nil,                              // 30: nlp.SentimentResponse.ProbabilitiesEntry
+}
+var file_api_nlp_proto_depIdxs = []int32{
+	0,  // 0: nlp.SentimentResponse.sentiment:type_name -> nlp.SentimentLabel
+	30, // 1: nlp.SentimentResponse.probabilities:type_name -> nlp.SentimentResponse.ProbabilitiesEntry
+	4,  // 2: nlp.SentimentBatchRequest.items:type_name -> nlp.SentimentRequest
+	8,  // 3: nlp.SentimentBatchResponse.results:type_name -> nlp.SentimentBatchResult
+	5,  // 4: nlp.SentimentBatchResult.response:type_name -> nlp.SentimentResponse
+	9,  // 5: nlp.SentimentBatchResult.error:type_name -> nlp.ItemError
+	4,  // 6: nlp.SentimentStreamRequest.request:type_name -> nlp.SentimentRequest
+	5,  // 7: nlp.SentimentStreamResponse.response:type_name -> nlp.SentimentResponse
+	9,  // 8: nlp.SentimentStreamResponse.error:type_name -> nlp.ItemError
+	13, // 9: nlp.LemmatizeResponse.lemmas:type_name -> nlp.Lemma
+	1,  // 10: nlp.Token.kind:type_name -> nlp.TokenKind
+	16, // 11: nlp.TokenizeResponse.tokens:type_name -> nlp.Token
+	16, // 12: nlp.Sentence.tokens:type_name -> nlp.Token
+	19, // 13: nlp.SegmentSentencesResponse.sentences:type_name -> nlp.Sentence
+	2,  // 14: nlp.Entity.type:type_name -> nlp.EntityType
+	22, // 15: nlp.ExtractEntitiesResponse.entities:type_name -> nlp.Entity
+	3,  // 16: nlp.TaggedToken.tag:type_name -> nlp.PartOfSpeech
+	25, // 17: nlp.TagPartsOfSpeechResponse.tokens:type_name -> nlp.TaggedToken
+	0,  // 18: nlp.Aspect.label:type_name -> nlp.SentimentLabel
+	28, // 19: nlp.AnalyzeAspectsResponse.aspects:type_name -> nlp.Aspect
+	4,  // 20: nlp.NLPManager.AnalyzeSentiment:input_type -> nlp.SentimentRequest
+	6,  // 21: nlp.NLPManager.AnalyzeSentimentBatch:input_type -> nlp.SentimentBatchRequest
+	10, // 22: nlp.NLPManager.StreamSentiment:input_type -> nlp.SentimentStreamRequest
+	12, // 23: nlp.NLPManager.Lemmatize:input_type -> nlp.LemmatizeRequest
+	15, // 24: nlp.NLPManager.Tokenize:input_type -> nlp.TokenizeRequest
+	18, // 25: nlp.NLPManager.SegmentSentences:input_type -> nlp.SegmentSentencesRequest
+	21, // 26: nlp.NLPManager.ExtractEntities:input_type -> nlp.ExtractEntitiesRequest
+	24, // 27: nlp.NLPManager.TagPartsOfSpeech:input_type -> nlp.TagPartsOfSpeechRequest
+	27, // 28: nlp.NLPManager.AnalyzeAspects:input_type -> nlp.AnalyzeAspectsRequest
+	5,  // 29: nlp.NLPManager.AnalyzeSentiment:output_type -> nlp.SentimentResponse
+	7,  // 30: nlp.NLPManager.AnalyzeSentimentBatch:output_type -> nlp.SentimentBatchResponse
+	11, // 31: nlp.NLPManager.StreamSentiment:output_type -> nlp.SentimentStreamResponse
+	14, // 32: nlp.NLPManager.Lemmatize:output_type -> nlp.LemmatizeResponse
+	17, // 33: nlp.NLPManager.Tokenize:output_type -> nlp.TokenizeResponse
+	20, // 34: nlp.NLPManager.SegmentSentences:output_type -> nlp.SegmentSentencesResponse
+	23, // 35: nlp.NLPManager.ExtractEntities:output_type -> nlp.ExtractEntitiesResponse
+	26, // 36: nlp.NLPManager.TagPartsOfSpeech:output_type -> nlp.TagPartsOfSpeechResponse
+	29, // 37: nlp.NLPManager.AnalyzeAspects:output_type -> nlp.AnalyzeAspectsResponse
+	29, // [29:38] is the sub-list for method output_type
+	20, // [20:29] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
+}
+
+func init() { file_api_nlp_proto_init() }
+func file_api_nlp_proto_init() {
+	if File_api_nlp_proto != nil {
+		return
+	}
+	file_api_nlp_proto_msgTypes[4].OneofWrappers = []any{
+		(*SentimentBatchResult_Response)(nil),
+		(*SentimentBatchResult_Error)(nil),
+	}
+	file_api_nlp_proto_msgTypes[7].OneofWrappers = []any{
+		(*SentimentStreamResponse_Response)(nil),
+		(*SentimentStreamResponse_Error)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_nlp_proto_rawDesc), len(file_api_nlp_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      4,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_api_nlp_proto_goTypes,
 		DependencyIndexes: file_api_nlp_proto_depIdxs,
+		EnumInfos:         file_api_nlp_proto_enumTypes,
 		MessageInfos:      file_api_nlp_proto_msgTypes,
 	}.Build()
 	File_api_nlp_proto = out.File
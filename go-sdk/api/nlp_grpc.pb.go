@@ -19,7 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NLPManager_AnalyzeSentiment_FullMethodName = "/nlp.NLPManager/AnalyzeSentiment"
+	NLPManager_AnalyzeSentiment_FullMethodName      = "/nlp.NLPManager/AnalyzeSentiment"
+	NLPManager_AnalyzeSentimentBatch_FullMethodName = "/nlp.NLPManager/AnalyzeSentimentBatch"
+	NLPManager_StreamSentiment_FullMethodName       = "/nlp.NLPManager/StreamSentiment"
+	NLPManager_Lemmatize_FullMethodName             = "/nlp.NLPManager/Lemmatize"
+	NLPManager_Tokenize_FullMethodName              = "/nlp.NLPManager/Tokenize"
+	NLPManager_SegmentSentences_FullMethodName      = "/nlp.NLPManager/SegmentSentences"
+	NLPManager_ExtractEntities_FullMethodName       = "/nlp.NLPManager/ExtractEntities"
+	NLPManager_TagPartsOfSpeech_FullMethodName      = "/nlp.NLPManager/TagPartsOfSpeech"
+	NLPManager_AnalyzeAspects_FullMethodName        = "/nlp.NLPManager/AnalyzeAspects"
 )
 
 // NLPManagerClient is the client API for NLPManager service.
@@ -27,6 +35,14 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NLPManagerClient interface {
 	AnalyzeSentiment(ctx context.Context, in *SentimentRequest, opts ...grpc.CallOption) (*SentimentResponse, error)
+	AnalyzeSentimentBatch(ctx context.Context, in *SentimentBatchRequest, opts ...grpc.CallOption) (*SentimentBatchResponse, error)
+	StreamSentiment(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SentimentStreamRequest, SentimentStreamResponse], error)
+	Lemmatize(ctx context.Context, in *LemmatizeRequest, opts ...grpc.CallOption) (*LemmatizeResponse, error)
+	Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error)
+	SegmentSentences(ctx context.Context, in *SegmentSentencesRequest, opts ...grpc.CallOption) (*SegmentSentencesResponse, error)
+	ExtractEntities(ctx context.Context, in *ExtractEntitiesRequest, opts ...grpc.CallOption) (*ExtractEntitiesResponse, error)
+	TagPartsOfSpeech(ctx context.Context, in *TagPartsOfSpeechRequest, opts ...grpc.CallOption) (*TagPartsOfSpeechResponse, error)
+	AnalyzeAspects(ctx context.Context, in *AnalyzeAspectsRequest, opts ...grpc.CallOption) (*AnalyzeAspectsResponse, error)
 }
 
 type nLPManagerClient struct {
@@ -47,11 +63,102 @@ func (c *nLPManagerClient) AnalyzeSentiment(ctx context.Context, in *SentimentRe
 	return out, nil
 }
 
+func (c *nLPManagerClient) AnalyzeSentimentBatch(ctx context.Context, in *SentimentBatchRequest, opts ...grpc.CallOption) (*SentimentBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SentimentBatchResponse)
+	err := c.cc.Invoke(ctx, NLPManager_AnalyzeSentimentBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLPManagerClient) StreamSentiment(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[SentimentStreamRequest, SentimentStreamResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &NLPManager_ServiceDesc.Streams[0], NLPManager_StreamSentiment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SentimentStreamRequest, SentimentStreamResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NLPManager_StreamSentimentClient = grpc.BidiStreamingClient[SentimentStreamRequest, SentimentStreamResponse]
+
+func (c *nLPManagerClient) Lemmatize(ctx context.Context, in *LemmatizeRequest, opts ...grpc.CallOption) (*LemmatizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LemmatizeResponse)
+	err := c.cc.Invoke(ctx, NLPManager_Lemmatize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLPManagerClient) Tokenize(ctx context.Context, in *TokenizeRequest, opts ...grpc.CallOption) (*TokenizeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TokenizeResponse)
+	err := c.cc.Invoke(ctx, NLPManager_Tokenize_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLPManagerClient) SegmentSentences(ctx context.Context, in *SegmentSentencesRequest, opts ...grpc.CallOption) (*SegmentSentencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SegmentSentencesResponse)
+	err := c.cc.Invoke(ctx, NLPManager_SegmentSentences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLPManagerClient) ExtractEntities(ctx context.Context, in *ExtractEntitiesRequest, opts ...grpc.CallOption) (*ExtractEntitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtractEntitiesResponse)
+	err := c.cc.Invoke(ctx, NLPManager_ExtractEntities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLPManagerClient) TagPartsOfSpeech(ctx context.Context, in *TagPartsOfSpeechRequest, opts ...grpc.CallOption) (*TagPartsOfSpeechResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TagPartsOfSpeechResponse)
+	err := c.cc.Invoke(ctx, NLPManager_TagPartsOfSpeech_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nLPManagerClient) AnalyzeAspects(ctx context.Context, in *AnalyzeAspectsRequest, opts ...grpc.CallOption) (*AnalyzeAspectsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AnalyzeAspectsResponse)
+	err := c.cc.Invoke(ctx, NLPManager_AnalyzeAspects_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NLPManagerServer is the server API for NLPManager service.
 // All implementations must embed UnimplementedNLPManagerServer
 // for forward compatibility.
 type NLPManagerServer interface {
 	AnalyzeSentiment(context.Context, *SentimentRequest) (*SentimentResponse, error)
+	AnalyzeSentimentBatch(context.Context, *SentimentBatchRequest) (*SentimentBatchResponse, error)
+	StreamSentiment(grpc.BidiStreamingServer[SentimentStreamRequest, SentimentStreamResponse]) error
+	Lemmatize(context.Context, *LemmatizeRequest) (*LemmatizeResponse, error)
+	Tokenize(context.Context, *TokenizeRequest) (*TokenizeResponse, error)
+	SegmentSentences(context.Context, *SegmentSentencesRequest) (*SegmentSentencesResponse, error)
+	ExtractEntities(context.Context, *ExtractEntitiesRequest) (*ExtractEntitiesResponse, error)
+	TagPartsOfSpeech(context.Context, *TagPartsOfSpeechRequest) (*TagPartsOfSpeechResponse, error)
+	AnalyzeAspects(context.Context, *AnalyzeAspectsRequest) (*AnalyzeAspectsResponse, error)
 	mustEmbedUnimplementedNLPManagerServer()
 }
 
@@ -65,6 +172,30 @@ type UnimplementedNLPManagerServer struct{}
 func (UnimplementedNLPManagerServer) AnalyzeSentiment(context.Context, *SentimentRequest) (*SentimentResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method AnalyzeSentiment not implemented")
 }
+func (UnimplementedNLPManagerServer) AnalyzeSentimentBatch(context.Context, *SentimentBatchRequest) (*SentimentBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeSentimentBatch not implemented")
+}
+func (UnimplementedNLPManagerServer) StreamSentiment(grpc.BidiStreamingServer[SentimentStreamRequest, SentimentStreamResponse]) error {
+	return status.Error(codes.Unimplemented, "method StreamSentiment not implemented")
+}
+func (UnimplementedNLPManagerServer) Lemmatize(context.Context, *LemmatizeRequest) (*LemmatizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Lemmatize not implemented")
+}
+func (UnimplementedNLPManagerServer) Tokenize(context.Context, *TokenizeRequest) (*TokenizeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Tokenize not implemented")
+}
+func (UnimplementedNLPManagerServer) SegmentSentences(context.Context, *SegmentSentencesRequest) (*SegmentSentencesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SegmentSentences not implemented")
+}
+func (UnimplementedNLPManagerServer) ExtractEntities(context.Context, *ExtractEntitiesRequest) (*ExtractEntitiesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExtractEntities not implemented")
+}
+func (UnimplementedNLPManagerServer) TagPartsOfSpeech(context.Context, *TagPartsOfSpeechRequest) (*TagPartsOfSpeechResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method TagPartsOfSpeech not implemented")
+}
+func (UnimplementedNLPManagerServer) AnalyzeAspects(context.Context, *AnalyzeAspectsRequest) (*AnalyzeAspectsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AnalyzeAspects not implemented")
+}
 func (UnimplementedNLPManagerServer) mustEmbedUnimplementedNLPManagerServer() {}
 func (UnimplementedNLPManagerServer) testEmbeddedByValue()                    {}
 
@@ -104,6 +235,139 @@ func _NLPManager_AnalyzeSentiment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NLPManager_AnalyzeSentimentBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SentimentBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).AnalyzeSentimentBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_AnalyzeSentimentBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).AnalyzeSentimentBatch(ctx, req.(*SentimentBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLPManager_StreamSentiment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(NLPManagerServer).StreamSentiment(&grpc.GenericServerStream[SentimentStreamRequest, SentimentStreamResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type NLPManager_StreamSentimentServer = grpc.BidiStreamingServer[SentimentStreamRequest, SentimentStreamResponse]
+
+func _NLPManager_Lemmatize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LemmatizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).Lemmatize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_Lemmatize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).Lemmatize(ctx, req.(*LemmatizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLPManager_Tokenize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TokenizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).Tokenize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_Tokenize_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).Tokenize(ctx, req.(*TokenizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLPManager_SegmentSentences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SegmentSentencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).SegmentSentences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_SegmentSentences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).SegmentSentences(ctx, req.(*SegmentSentencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLPManager_ExtractEntities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtractEntitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).ExtractEntities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_ExtractEntities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).ExtractEntities(ctx, req.(*ExtractEntitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLPManager_TagPartsOfSpeech_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TagPartsOfSpeechRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).TagPartsOfSpeech(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_TagPartsOfSpeech_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).TagPartsOfSpeech(ctx, req.(*TagPartsOfSpeechRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NLPManager_AnalyzeAspects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnalyzeAspectsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NLPManagerServer).AnalyzeAspects(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NLPManager_AnalyzeAspects_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NLPManagerServer).AnalyzeAspects(ctx, req.(*AnalyzeAspectsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NLPManager_ServiceDesc is the grpc.ServiceDesc for NLPManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,7 +379,42 @@ var NLPManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AnalyzeSentiment",
 			Handler:    _NLPManager_AnalyzeSentiment_Handler,
 		},
+		{
+			MethodName: "AnalyzeSentimentBatch",
+			Handler:    _NLPManager_AnalyzeSentimentBatch_Handler,
+		},
+		{
+			MethodName: "Lemmatize",
+			Handler:    _NLPManager_Lemmatize_Handler,
+		},
+		{
+			MethodName: "Tokenize",
+			Handler:    _NLPManager_Tokenize_Handler,
+		},
+		{
+			MethodName: "SegmentSentences",
+			Handler:    _NLPManager_SegmentSentences_Handler,
+		},
+		{
+			MethodName: "ExtractEntities",
+			Handler:    _NLPManager_ExtractEntities_Handler,
+		},
+		{
+			MethodName: "TagPartsOfSpeech",
+			Handler:    _NLPManager_TagPartsOfSpeech_Handler,
+		},
+		{
+			MethodName: "AnalyzeAspects",
+			Handler:    _NLPManager_AnalyzeAspects_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamSentiment",
+			Handler:       _NLPManager_StreamSentiment_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/nlp.proto",
 }
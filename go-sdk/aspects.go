@@ -0,0 +1,48 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// Aspect is the sentiment a text expresses about one aspect it mentions, such
+// as the delivery of an order
+type Aspect struct {
+	// Term is the aspect as written in the text, e.g. پیک
+	Term string
+	// Category is the category of the term in the aspect taxonomy of the
+	// server, e.g. "delivery"
+	Category string
+	// Start and End are the offsets of the term in the text in runes, End exclusive
+	Start, End int
+	Label      Sentiment
+	Score      float64
+}
+
+// AnalyzeAspects returns the sentiment of text about each aspect it mentions,
+// in order, so that "the food was great but delivery was late" yields a
+// positive food and a negative delivery aspect
+func (c *Client) AnalyzeAspects(ctx context.Context, text string) ([]Aspect, error) {
+	resp, err := c.client.AnalyzeAspects(ctx, &pb.AnalyzeAspectsRequest{
+		Text: text,
+		Lang: "fa",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("aspect analysis failed: %w", err)
+	}
+
+	aspects := make([]Aspect, len(resp.Aspects))
+	for i, a := range resp.Aspects {
+		aspects[i] = Aspect{
+			Term:     a.Term,
+			Category: a.Category,
+			Start:    int(a.Start),
+			End:      int(a.End),
+			Label:    sentimentFromProto(a.Label),
+			Score:    a.Score,
+		}
+	}
+	return aspects, nil
+}
@@ -0,0 +1,42 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestAnalyzeAspects(t *testing.T) {
+	client := zennlptest.NewServer(t).Client(t)
+
+	text := "غذا عالی بود ولی پیک خیلی دیر رسید"
+	aspects, err := client.AnalyzeAspects(context.Background(), text)
+	if err != nil {
+		t.Fatalf("AnalyzeAspects() error = %v", err)
+	}
+
+	want := []struct {
+		term, category string
+		label          go_sdk.Sentiment
+	}{
+		{"غذا", "food", go_sdk.SentimentPositive},
+		{"پیک", "delivery", go_sdk.SentimentNegative},
+	}
+	if len(aspects) != len(want) {
+		t.Fatalf("AnalyzeAspects() = %v, want %d aspects", aspects, len(want))
+	}
+	runes := []rune(text)
+	for i, a := range aspects {
+		if a.Term != want[i].term || a.Category != want[i].category || a.Label != want[i].label {
+			t.Errorf("aspect %d = %q %s %s, want %q %s %s", i, a.Term, a.Category, a.Label, want[i].term, want[i].category, want[i].label)
+		}
+		if string(runes[a.Start:a.End]) != a.Term {
+			t.Errorf("offsets of %q select %q", a.Term, string(runes[a.Start:a.End]))
+		}
+		if a.Score <= 0 || a.Score > 1 {
+			t.Errorf("score of %q = %v", a.Term, a.Score)
+		}
+	}
+}
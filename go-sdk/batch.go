@@ -0,0 +1,132 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ItemError is the failure of a single item within a batch
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+// BatchError reports the items of a batch that could not be analyzed.
+// Results for the remaining items are still returned.
+type BatchError struct {
+	Items []ItemError
+}
+
+// Error implements the error interface
+func (e *BatchError) Error() string {
+	parts := make([]string, 0, len(e.Items))
+	for _, item := range e.Items {
+		parts = append(parts, fmt.Sprintf("item %d: %v", item.Index, item.Err))
+	}
+	return fmt.Sprintf("%d batch item(s) failed: %s", len(e.Items), strings.Join(parts, "; "))
+}
+
+// AnalyzeBatch performs sentiment analysis on several texts in a single round trip.
+// The returned slice has the same length and order as texts. Items that failed
+// are nil and are reported through a *BatchError. Calls run at PriorityBulk unless
+// ctx sets a priority.
+func (c *Client) AnalyzeBatch(ctx context.Context, texts []string) ([]*Result, error) {
+	return c.AnalyzeBatchWithLanguage(ctx, texts, "fa")
+}
+
+// AnalyzeBatchWithLanguage performs batch sentiment analysis with the same language for every text
+func (c *Client) AnalyzeBatchWithLanguage(ctx context.Context, texts []string, lang string) ([]*Result, error) {
+	items := make([]*pb.SentimentRequest, len(texts))
+	for i, text := range texts {
+		items[i] = &pb.SentimentRequest{
+			Text: text,
+			Lang: lang,
+		}
+	}
+	return c.analyzeBatch(ctx, items)
+}
+
+// BatchItem is a text of a batch with its own language
+type BatchItem struct {
+	Text string
+	// Lang is the language of the text, Persian ("fa") when empty
+	Lang string
+}
+
+// AnalyzeBatchItems performs batch sentiment analysis with a language per item,
+// e.g. for reviews mixing Persian and English. Results and errors are reported
+// as by AnalyzeBatch.
+func (c *Client) AnalyzeBatchItems(ctx context.Context, items []BatchItem) ([]*Result, error) {
+	reqs := make([]*pb.SentimentRequest, len(items))
+	for i, item := range items {
+		lang := item.Lang
+		if lang == "" {
+			lang = "fa"
+		}
+		reqs[i] = &pb.SentimentRequest{
+			Text: item.Text,
+			Lang: lang,
+		}
+	}
+	return c.analyzeBatch(ctx, reqs)
+}
+
+func (c *Client) analyzeBatch(ctx context.Context, items []*pb.SentimentRequest) ([]*Result, error) {
+	if len(items) == 0 {
+		return []*Result{}, nil
+	}
+
+	ctx = defaultPriority(ctx, PriorityBulk)
+	resp, err := c.client.AnalyzeSentimentBatch(ctx, &pb.SentimentBatchRequest{Items: items})
+	if err != nil {
+		return nil, fmt.Errorf("batch sentiment analysis failed: %w", err)
+	}
+
+	results := make([]*Result, len(items))
+	seen := make([]bool, len(items))
+	var failed []ItemError
+
+	for _, r := range resp.Results {
+		idx := int(r.Index)
+		if idx < 0 || idx >= len(items) || seen[idx] {
+			return nil, fmt.Errorf("batch sentiment analysis failed: invalid result index %d", idx)
+		}
+		seen[idx] = true
+
+		switch outcome := r.Outcome.(type) {
+		case *pb.SentimentBatchResult_Response:
+			results[idx] = newResult(outcome.Response)
+		case *pb.SentimentBatchResult_Error:
+			failed = append(failed, ItemError{
+				Index: idx,
+				Err:   status.Error(codes.Code(outcome.Error.Code), outcome.Error.Message),
+			})
+		default:
+			failed = append(failed, ItemError{
+				Index: idx,
+				Err:   status.Error(codes.Internal, "empty batch result"),
+			})
+		}
+	}
+
+	for idx, ok := range seen {
+		if !ok {
+			failed = append(failed, ItemError{
+				Index: idx,
+				Err:   status.Error(codes.Internal, "missing batch result"),
+			})
+		}
+	}
+
+	if len(failed) > 0 {
+		sort.Slice(failed, func(i, j int) bool { return failed[i].Index < failed[j].Index })
+		return results, &BatchError{Items: failed}
+	}
+	return results, nil
+}
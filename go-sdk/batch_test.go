@@ -0,0 +1,65 @@
+package go_sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAnalyzeBatchReportsPartialFailures(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("خطا").Fail(codes.Internal, "model crashed")
+	client := srv.Client(t)
+
+	texts := []string{"غذا عالی بود", "خطا", "ارسال افتضاح بود"}
+	results, err := client.AnalyzeBatch(context.Background(), texts)
+
+	var batchErr *go_sdk.BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected *BatchError, got %v", err)
+	}
+	if len(batchErr.Items) != 1 || batchErr.Items[0].Index != 1 || status.Code(batchErr.Items[0].Err) != codes.Internal {
+		t.Errorf("unexpected item errors: %v", batchErr.Items)
+	}
+
+	if len(results) != len(texts) {
+		t.Fatalf("expected %d results, got %d", len(texts), len(results))
+	}
+	if results[0] == nil || !results[0].IsPositive() {
+		t.Errorf("item 0 should be positive, got %v", results[0])
+	}
+	if results[1] != nil {
+		t.Errorf("failed item should be nil, got %v", results[1])
+	}
+	if results[2] == nil || !results[2].IsNegative() {
+		t.Errorf("item 2 should be negative, got %v", results[2])
+	}
+}
+
+func TestAnalyzeBatchItemsSendsLanguagePerItem(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	// The lexicon server only knows Persian
+	srv.On("great food").Return("positive", 0.9)
+	client := srv.Client(t)
+
+	results, err := client.AnalyzeBatchItems(context.Background(), []go_sdk.BatchItem{
+		{Text: "غذا عالی بود"},
+		{Text: "great food", Lang: "en"},
+	})
+	if err != nil {
+		t.Fatalf("AnalyzeBatchItems() error = %v", err)
+	}
+	if len(results) != 2 || !results[0].IsPositive() || !results[1].IsPositive() {
+		t.Fatalf("unexpected results %v", results)
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 2 || reqs[0].Lang != "fa" || reqs[1].Lang != "en" {
+		t.Errorf("unexpected requests %+v", reqs)
+	}
+}
@@ -0,0 +1,343 @@
+package go_sdk
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrCircuitOpen is returned without contacting the server while the circuit
+// breaker is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// CircuitState is the state of a circuit breaker
+type CircuitState int
+
+const (
+	// CircuitClosed lets every call through and counts failures
+	CircuitClosed CircuitState = iota
+	// CircuitOpen fails every call with ErrCircuitOpen until the cool-down ends
+	CircuitOpen
+	// CircuitHalfOpen lets a limited number of probe calls through to decide
+	// whether to close or open the circuit again
+	CircuitHalfOpen
+)
+
+// String returns the lower-case name of the state
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// breakerBuckets is the number of buckets the failure window is split into
+const breakerBuckets = 10
+
+// minBreakerWindow is the shortest window, so every bucket spans some time
+const minBreakerWindow = time.Second
+
+// CircuitBreakerPolicy controls when the client stops sending calls to an
+// unhealthy server. One breaker is shared by every RPC of a client, and every
+// attempt of a retried call counts separately.
+type CircuitBreakerPolicy struct {
+	// FailureRatio is the share of failed calls in the window that opens the circuit.
+	FailureRatio float64
+	// MinRequests is the number of calls in the window before the ratio is considered.
+	MinRequests int
+	// Window is the rolling period over which calls are counted. Windows
+	// shorter than a second are raised to one second.
+	Window time.Duration
+	// CoolDown is how long the circuit stays open before probing the server.
+	CoolDown time.Duration
+	// HalfOpenRequests is the number of probe calls allowed while half-open. The
+	// circuit closes once all of them succeed and opens again on any failure.
+	HalfOpenRequests int
+	// FailureCodes lists the status codes that count as failures. Other errors,
+	// such as invalid arguments, count as successes.
+	FailureCodes []codes.Code
+	// OnStateChange is called after every transition, outside any lock.
+	OnStateChange func(from, to CircuitState)
+}
+
+// DefaultCircuitBreakerPolicy returns the values used for unset policy fields
+func DefaultCircuitBreakerPolicy() CircuitBreakerPolicy {
+	return CircuitBreakerPolicy{
+		FailureRatio:     0.5,
+		MinRequests:      10,
+		Window:           10 * time.Second,
+		CoolDown:         30 * time.Second,
+		HalfOpenRequests: 1,
+		FailureCodes: []codes.Code{
+			codes.Unavailable, codes.ResourceExhausted, codes.DeadlineExceeded,
+			codes.Internal, codes.Unknown, codes.Aborted,
+		},
+	}
+}
+
+// withDefaults fills zero fields from DefaultCircuitBreakerPolicy
+func (p CircuitBreakerPolicy) withDefaults() CircuitBreakerPolicy {
+	def := DefaultCircuitBreakerPolicy()
+	if p.FailureRatio <= 0 || p.FailureRatio > 1 {
+		p.FailureRatio = def.FailureRatio
+	}
+	if p.MinRequests <= 0 {
+		p.MinRequests = def.MinRequests
+	}
+	if p.Window <= 0 {
+		p.Window = def.Window
+	} else if p.Window < minBreakerWindow {
+		p.Window = minBreakerWindow
+	}
+	if p.CoolDown <= 0 {
+		p.CoolDown = def.CoolDown
+	}
+	if p.HalfOpenRequests <= 0 {
+		p.HalfOpenRequests = def.HalfOpenRequests
+	}
+	if p.FailureCodes == nil {
+		p.FailureCodes = def.FailureCodes
+	}
+	return p
+}
+
+// failure reports whether err counts against the server
+func (p CircuitBreakerPolicy) failure(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := status.Code(err)
+	for _, c := range p.FailureCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+type breakerBucket struct {
+	start     time.Time
+	successes int
+	failures  int
+}
+
+// circuitBreaker implements the closed, open and half-open states
+type circuitBreaker struct {
+	policy CircuitBreakerPolicy
+	now    func() time.Time
+
+	mu         sync.Mutex
+	state      CircuitState
+	generation uint64
+	openedAt   time.Time
+	buckets    [breakerBuckets]breakerBucket
+	probes     int
+	probeOKs   int
+}
+
+func newCircuitBreaker(policy CircuitBreakerPolicy) *circuitBreaker {
+	return &circuitBreaker{policy: policy.withDefaults(), now: time.Now}
+}
+
+// State returns the current state, moving from open to half-open once the cool-down is over
+func (b *circuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	from, to := b.state, b.advanceLocked()
+	b.mu.Unlock()
+	b.notify(from, to)
+	return to
+}
+
+// allow admits a call, returning the func to report its outcome with
+func (b *circuitBreaker) allow() (func(error), error) {
+	b.mu.Lock()
+	from := b.state
+	state := b.advanceLocked()
+	if state == CircuitOpen || (state == CircuitHalfOpen && b.probes >= b.policy.HalfOpenRequests) {
+		b.mu.Unlock()
+		b.notify(from, state)
+		return nil, ErrCircuitOpen
+	}
+	if state == CircuitHalfOpen {
+		b.probes++
+	}
+	generation := b.generation
+	b.mu.Unlock()
+	b.notify(from, state)
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() { b.done(generation, err) })
+	}, nil
+}
+
+// done records the outcome of a call admitted in the given generation
+func (b *circuitBreaker) done(generation uint64, err error) {
+	failed := b.policy.failure(err)
+
+	b.mu.Lock()
+	if generation != b.generation {
+		// The state changed while the call was in flight
+		b.mu.Unlock()
+		return
+	}
+	from := b.state
+	switch b.state {
+	case CircuitClosed:
+		if canceled(err) {
+			break
+		}
+		b.recordLocked(failed)
+		if total, failures := b.countsLocked(); total >= b.policy.MinRequests && float64(failures) >= b.policy.FailureRatio*float64(total) {
+			b.setLocked(CircuitOpen)
+		}
+	case CircuitHalfOpen:
+		b.probes--
+		if canceled(err) {
+			// A probe the caller gave up on frees its slot without deciding anything
+			break
+		}
+		if failed {
+			b.setLocked(CircuitOpen)
+		} else if b.probeOKs++; b.probeOKs >= b.policy.HalfOpenRequests {
+			b.setLocked(CircuitClosed)
+		}
+	}
+	to := b.state
+	b.mu.Unlock()
+	b.notify(from, to)
+}
+
+// canceled reports whether the caller gave up on the call, which says nothing
+// about the server
+func canceled(err error) bool {
+	return errors.Is(err, context.Canceled) || status.Code(err) == codes.Canceled
+}
+
+func (b *circuitBreaker) advanceLocked() CircuitState {
+	if b.state == CircuitOpen && b.now().Sub(b.openedAt) >= b.policy.CoolDown {
+		b.setLocked(CircuitHalfOpen)
+	}
+	return b.state
+}
+
+func (b *circuitBreaker) setLocked(state CircuitState) {
+	b.state = state
+	b.generation++
+	b.probes, b.probeOKs = 0, 0
+	switch state {
+	case CircuitOpen:
+		b.openedAt = b.now()
+	case CircuitClosed:
+		b.buckets = [breakerBuckets]breakerBucket{}
+	}
+}
+
+func (b *circuitBreaker) recordLocked(failed bool) {
+	width := b.policy.Window / breakerBuckets
+	start := b.now().Truncate(width)
+	bucket := &b.buckets[start.UnixNano()/int64(width)%breakerBuckets]
+	if !bucket.start.Equal(start) {
+		*bucket = breakerBucket{start: start}
+	}
+	if failed {
+		bucket.failures++
+	} else {
+		bucket.successes++
+	}
+}
+
+func (b *circuitBreaker) countsLocked() (total, failures int) {
+	now := b.now()
+	for _, bucket := range b.buckets {
+		if now.Sub(bucket.start) < b.policy.Window {
+			total += bucket.successes + bucket.failures
+			failures += bucket.failures
+		}
+	}
+	return total, failures
+}
+
+func (b *circuitBreaker) notify(from, to CircuitState) {
+	if from != to && b.policy.OnStateChange != nil {
+		b.policy.OnStateChange(from, to)
+	}
+}
+
+// unaryInterceptor fails unary calls fast while the circuit is open
+func (b *circuitBreaker) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !isNLPMethod(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		done, err := b.allow()
+		if err != nil {
+			return err
+		}
+		err = invoker(ctx, method, req, reply, cc, opts...)
+		done(err)
+		return err
+	}
+}
+
+// streamInterceptor fails new streams fast while the circuit is open and
+// reports how each stream ended. Streams abandoned before EOF report when
+// their context is done, so a half-open probe always gives back its slot.
+func (b *circuitBreaker) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !isNLPMethod(method) {
+			return streamer(ctx, desc, cc, method, opts...)
+		}
+		done, err := b.allow()
+		if err != nil {
+			return nil, err
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			done(err)
+			return nil, err
+		}
+		stop := context.AfterFunc(ctx, func() {
+			done(status.FromContextError(ctx.Err()).Err())
+		})
+		return &breakerStream{ClientStream: stream, done: done, stop: stop}, nil
+	}
+}
+
+type breakerStream struct {
+	grpc.ClientStream
+	done func(error)
+	stop func() bool
+}
+
+func (s *breakerStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if errors.Is(err, io.EOF) {
+		s.stop()
+		s.done(nil)
+	} else if err != nil {
+		s.stop()
+		s.done(err)
+	}
+	return err
+}
+
+// isNLPMethod reports whether method belongs to NLPManager. Health checks
+// bypass the breaker so WaitForReady can tell when the server is back.
+func isNLPMethod(method string) bool {
+	return strings.HasPrefix(method, "/"+pb.NLPManager_ServiceDesc.ServiceName+"/")
+}
@@ -0,0 +1,198 @@
+package go_sdk_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCircuitBreakerOpensAndRecovers(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().Fail(codes.Unavailable, "overloaded").Times(4)
+
+	var mu sync.Mutex
+	var transitions []string
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Retry:   &go_sdk.RetryPolicy{MaxAttempts: 1},
+		CircuitBreaker: &go_sdk.CircuitBreakerPolicy{
+			FailureRatio: 0.5,
+			MinRequests:  4,
+			CoolDown:     50 * time.Millisecond,
+			OnStateChange: func(from, to go_sdk.CircuitState) {
+				mu.Lock()
+				defer mu.Unlock()
+				transitions = append(transitions, from.String()+"->"+to.String())
+			},
+		},
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 4; i++ {
+		if _, err := client.Analyze(ctx, "خوب"); err == nil {
+			t.Fatalf("call %d: expected an error", i)
+		}
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitOpen {
+		t.Fatalf("expected open circuit, got %s", state)
+	}
+
+	_, err := client.Analyze(ctx, "خوب")
+	if !errors.Is(err, go_sdk.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if _, err := client.AnalyzeBatch(ctx, []string{"خوب"}); !errors.Is(err, go_sdk.ErrCircuitOpen) {
+		t.Fatalf("expected the breaker to be shared by AnalyzeBatch, got %v", err)
+	}
+	if n := len(srv.Requests()); n != 4 {
+		t.Errorf("expected no requests while open, got %d", n)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.Analyze(ctx, "خوب"); err != nil {
+		t.Fatalf("probe call error = %v", err)
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitClosed {
+		t.Fatalf("expected closed circuit after a successful probe, got %s", state)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{"closed->open", "open->half-open", "half-open->closed"}
+	if len(transitions) != len(want) {
+		t.Fatalf("transitions = %v, want %v", transitions, want)
+	}
+	for i := range want {
+		if transitions[i] != want[i] {
+			t.Errorf("transitions = %v, want %v", transitions, want)
+			break
+		}
+	}
+}
+
+func TestCircuitBreakerStopsRetries(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().Fail(codes.Unavailable, "overloaded")
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:        5 * time.Second,
+		Retry:          &go_sdk.RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Millisecond},
+		CircuitBreaker: &go_sdk.CircuitBreakerPolicy{MinRequests: 2, CoolDown: time.Minute},
+	})
+
+	_, err := client.AnalyzeWithRetry(context.Background(), "خوب", 4)
+	if !errors.Is(err, go_sdk.ErrCircuitOpen) {
+		t.Fatalf("expected ErrCircuitOpen, got %v", err)
+	}
+	if n := srv.Count("خوب"); n != 2 {
+		t.Errorf("expected the breaker to stop retries after 2 attempts, got %d", n)
+	}
+}
+
+func TestCircuitBreakerIgnoresClientErrors(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().Fail(codes.InvalidArgument, "bad text")
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:        5 * time.Second,
+		CircuitBreaker: &go_sdk.CircuitBreakerPolicy{MinRequests: 2},
+	})
+
+	for i := 0; i < 5; i++ {
+		client.Analyze(context.Background(), "خوب")
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitClosed {
+		t.Errorf("expected closed circuit, got %s", state)
+	}
+}
+
+func TestCircuitBreakerReleasesAbandonedProbeStream(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().Fail(codes.Unavailable, "overloaded").Times(2)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:        5 * time.Second,
+		Retry:          &go_sdk.RetryPolicy{MaxAttempts: 1},
+		CircuitBreaker: &go_sdk.CircuitBreakerPolicy{MinRequests: 2, CoolDown: 20 * time.Millisecond},
+	})
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		client.Analyze(ctx, "خوب")
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitOpen {
+		t.Fatalf("expected open circuit, got %s", state)
+	}
+	time.Sleep(30 * time.Millisecond)
+
+	stream, err := client.Stream(ctx)
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	if _, err := client.Analyze(ctx, "خوب"); !errors.Is(err, go_sdk.ErrCircuitOpen) {
+		t.Fatalf("expected the stream to hold the only probe slot, got %v", err)
+	}
+	stream.Close()
+
+	// The slot is given back asynchronously once the stream context is done
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, err := client.Analyze(ctx, "خوب")
+		if err == nil {
+			break
+		}
+		if !errors.Is(err, go_sdk.ErrCircuitOpen) || time.Now().After(deadline) {
+			t.Fatalf("expected a unary call to be allowed after the stream was abandoned, got %v", err)
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitClosed {
+		t.Errorf("expected closed circuit after a successful probe, got %s", state)
+	}
+}
+
+func TestCircuitBreakerIgnoresCanceledProbe(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().Fail(codes.Unavailable, "overloaded").Times(2)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:        5 * time.Second,
+		Retry:          &go_sdk.RetryPolicy{MaxAttempts: 1},
+		CircuitBreaker: &go_sdk.CircuitBreakerPolicy{MinRequests: 2, CoolDown: 20 * time.Millisecond},
+	})
+
+	for i := 0; i < 2; i++ {
+		client.Analyze(context.Background(), "خوب")
+	}
+	time.Sleep(30 * time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Analyze(ctx, "خوب"); err == nil {
+		t.Fatal("expected the cancelled probe to fail")
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitHalfOpen {
+		t.Errorf("expected a cancelled probe to leave the circuit half-open, got %s", state)
+	}
+}
+
+func TestCircuitBreakerTinyWindow(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().Fail(codes.Unavailable, "overloaded")
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:        5 * time.Second,
+		Retry:          &go_sdk.RetryPolicy{MaxAttempts: 1},
+		CircuitBreaker: &go_sdk.CircuitBreakerPolicy{Window: 5, MinRequests: 2},
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Analyze(context.Background(), "خوب"); err == nil {
+			t.Fatalf("call %d: expected an error", i)
+		}
+	}
+	if state := client.CircuitState(); state != go_sdk.CircuitOpen {
+		t.Errorf("expected the raised window to count both failures, got %s", state)
+	}
+}
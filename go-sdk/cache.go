@@ -0,0 +1,415 @@
+package go_sdk
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// CachePolicy keeps recent sentiment results, so repeated texts are not
+// analyzed again
+type CachePolicy struct {
+	// MaxEntries bounds the number of results of the default in-memory cache,
+	// evicting the least recently used. Defaults to 10000.
+	MaxEntries int
+	// TTL is how long a result of the default in-memory cache stays valid.
+	// Defaults to one hour.
+	TTL time.Duration
+	// ModelVersion pins the model version results are cached for; results of
+	// other versions are not cached. By default the cache follows the version
+	// reported by the engine and drops other versions when it changes. Until
+	// the first response, it looks up the version of the latest result of the
+	// Store, so a FileCache or warmed-up cache hits right after a restart.
+	ModelVersion string
+	// Store holds the results, e.g. a FileCache to keep them across restarts.
+	// Defaults to a MemoryCache with MaxEntries and TTL.
+	Store Cache
+}
+
+// withDefaults fills zero fields of the policy
+func (p CachePolicy) withDefaults() CachePolicy {
+	if p.MaxEntries <= 0 {
+		p.MaxEntries = 10000
+	}
+	if p.TTL <= 0 {
+		p.TTL = time.Hour
+	}
+	if p.Store == nil {
+		p.Store = NewMemoryCache(p.MaxEntries, p.TTL)
+	}
+	return p
+}
+
+// CacheKey identifies a cached result
+type CacheKey struct {
+	// Text is the analyzed text with whitespace and the Arabic forms of
+	// Persian letters normalized, so spelling variants share an entry
+	Text string
+	// Lang is the lower-case language, "fa" when the request has none
+	Lang string
+	// ModelVersion is the version of the model that produced the result
+	ModelVersion string
+}
+
+// cacheText normalizes the Arabic forms of Persian letters
+var cacheText = strings.NewReplacer("ي", "ی", "ى", "ی", "ك", "ک")
+
+// NewCacheKey returns the key of the result of text in lang by the given model version
+func NewCacheKey(text, lang, modelVersion string) CacheKey {
+	lang = strings.ToLower(lang)
+	if lang == "" {
+		lang = "fa"
+	}
+	return CacheKey{
+		Text:         cacheText.Replace(strings.Join(strings.Fields(text), " ")),
+		Lang:         lang,
+		ModelVersion: modelVersion,
+	}
+}
+
+// Cache stores sentiment results. Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the result stored under key. Callers must not modify it.
+	Get(key CacheKey) (*pb.SentimentResponse, bool)
+	// Put stores resp under key. The cache may keep resp without copying it.
+	Put(key CacheKey, resp *pb.SentimentResponse)
+	// Invalidate drops every result of a model version other than current
+	Invalidate(current string)
+	// Len returns the number of stored results
+	Len() int
+}
+
+// CacheStats counts the work saved by the result cache
+type CacheStats struct {
+	// Hits is the number of texts answered from the cache
+	Hits int64
+	// Misses is the number of texts sent to the server
+	Misses int64
+	// Shared is the number of calls that waited for an identical call in
+	// flight instead of sending their own
+	Shared int64
+	// Evictions is the number of results dropped by a MemoryCache because of
+	// its size, TTL or a new model version
+	Evictions int64
+	// Entries is the number of results currently cached
+	Entries int
+}
+
+type memoryEntry struct {
+	key     CacheKey
+	resp    *pb.SentimentResponse
+	expires time.Time
+}
+
+// MemoryCache is an in-memory LRU Cache whose results expire after a TTL
+type MemoryCache struct {
+	maxEntries int
+	ttl        time.Duration
+	now        func() time.Time
+
+	mu        sync.Mutex
+	lru       *list.List
+	entries   map[CacheKey]*list.Element
+	version   string
+	evictions atomic.Int64
+}
+
+// NewMemoryCache creates a cache of at most maxEntries results, each valid for
+// ttl. Zero values mean no limit.
+func NewMemoryCache(maxEntries int, ttl time.Duration) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		now:        time.Now,
+		lru:        list.New(),
+		entries:    make(map[CacheKey]*list.Element),
+	}
+}
+
+// Get implements Cache
+func (m *MemoryCache) Get(key CacheKey) (*pb.SentimentResponse, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*memoryEntry)
+	if m.ttl > 0 && !m.now().Before(entry.expires) {
+		m.removeLocked(el)
+		return nil, false
+	}
+	m.lru.MoveToFront(el)
+	return entry.resp, true
+}
+
+// Put implements Cache
+func (m *MemoryCache) Put(key CacheKey, resp *pb.SentimentResponse) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.lru.Remove(el)
+	}
+	m.entries[key] = m.lru.PushFront(&memoryEntry{key: key, resp: resp, expires: m.now().Add(m.ttl)})
+	m.version = key.ModelVersion
+	for m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		m.removeLocked(m.lru.Back())
+	}
+}
+
+// Invalidate implements Cache
+func (m *MemoryCache) Invalidate(current string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.version = current
+	for key, el := range m.entries {
+		if key.ModelVersion != current {
+			m.removeLocked(el)
+		}
+	}
+}
+
+// Len implements Cache
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
+
+// ModelVersion returns the model version of the latest stored result
+func (m *MemoryCache) ModelVersion() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.version
+}
+
+// Evictions returns the number of results dropped before being replaced
+func (m *MemoryCache) Evictions() int64 {
+	return m.evictions.Load()
+}
+
+func (m *MemoryCache) removeLocked(el *list.Element) {
+	m.lru.Remove(el)
+	delete(m.entries, el.Value.(*memoryEntry).key)
+	m.evictions.Add(1)
+}
+
+// flight is a call in progress that identical calls wait for
+type flight struct {
+	done chan struct{}
+	resp *pb.SentimentResponse
+	err  error
+}
+
+// resultCache answers calls from a Cache, sharing identical calls in flight
+type resultCache struct {
+	store  Cache
+	pinned string
+
+	mu      sync.Mutex
+	version string
+	flights map[CacheKey]*flight
+
+	hits, misses, shared atomic.Int64
+}
+
+func newResultCache(policy CachePolicy) *resultCache {
+	policy = policy.withDefaults()
+	return &resultCache{
+		store:   policy.Store,
+		pinned:  policy.ModelVersion,
+		version: policy.ModelVersion,
+		flights: make(map[CacheKey]*flight),
+	}
+}
+
+func (c *resultCache) key(req *pb.SentimentRequest) CacheKey {
+	c.mu.Lock()
+	version := c.version
+	c.mu.Unlock()
+	if version == "" {
+		// No response yet, so use the version results were stored under
+		version = storedVersion(c.store)
+	}
+	return NewCacheKey(req.Text, req.Lang, version)
+}
+
+// storedVersion returns the latest model version of stores that report it
+func storedVersion(store Cache) string {
+	if s, ok := store.(interface{ ModelVersion() string }); ok {
+		return s.ModelVersion()
+	}
+	return ""
+}
+
+// put caches resp under key, moved to the version resp was produced by
+func (c *resultCache) put(key CacheKey, resp *pb.SentimentResponse) {
+	c.mu.Lock()
+	changed := false
+	switch {
+	case c.pinned != "":
+		if resp.ModelVersion != "" && resp.ModelVersion != c.pinned {
+			c.mu.Unlock()
+			return
+		}
+	case resp.ModelVersion != c.version:
+		// The engine was upgraded, so the results of the old model are stale
+		c.version = resp.ModelVersion
+		changed = true
+	}
+	key.ModelVersion = c.version
+	c.mu.Unlock()
+
+	if changed {
+		c.store.Invalidate(key.ModelVersion)
+	}
+	c.store.Put(key, resp)
+}
+
+func (c *resultCache) stats() CacheStats {
+	stats := CacheStats{
+		Hits:    c.hits.Load(),
+		Misses:  c.misses.Load(),
+		Shared:  c.shared.Load(),
+		Entries: c.store.Len(),
+	}
+	if m, ok := c.store.(interface{ Evictions() int64 }); ok {
+		stats.Evictions = m.Evictions()
+	}
+	return stats
+}
+
+// unaryInterceptor answers AnalyzeSentiment calls and batch items from the cache
+func (c *resultCache) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		switch method {
+		case pb.NLPManager_AnalyzeSentiment_FullMethodName:
+			in, ok := req.(*pb.SentimentRequest)
+			out, ok2 := reply.(*pb.SentimentResponse)
+			if ok && ok2 {
+				return c.analyze(ctx, in, out, func(out *pb.SentimentResponse) error {
+					return invoker(ctx, method, in, out, cc, opts...)
+				})
+			}
+		case pb.NLPManager_AnalyzeSentimentBatch_FullMethodName:
+			in, ok := req.(*pb.SentimentBatchRequest)
+			out, ok2 := reply.(*pb.SentimentBatchResponse)
+			if ok && ok2 {
+				return c.analyzeBatch(in, out, func(in *pb.SentimentBatchRequest, out *pb.SentimentBatchResponse) error {
+					return invoker(ctx, method, in, out, cc, opts...)
+				})
+			}
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// analyze answers req from the cache, from an identical call in flight or
+// by invoking the server
+func (c *resultCache) analyze(ctx context.Context, req *pb.SentimentRequest, out *pb.SentimentResponse, invoke func(*pb.SentimentResponse) error) error {
+	key := c.key(req)
+	if resp, ok := c.store.Get(key); ok {
+		c.hits.Add(1)
+		proto.Merge(out, resp)
+		return nil
+	}
+
+	c.mu.Lock()
+	if f, ok := c.flights[key]; ok {
+		c.mu.Unlock()
+		select {
+		case <-f.done:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		if f.err == nil {
+			c.shared.Add(1)
+			proto.Merge(out, f.resp)
+			return nil
+		}
+		// The shared call may have failed only because its caller gave up
+		c.misses.Add(1)
+		err := invoke(out)
+		if err == nil {
+			c.put(key, proto.Clone(out).(*pb.SentimentResponse))
+		}
+		return err
+	}
+
+	f := &flight{done: make(chan struct{})}
+	c.flights[key] = f
+	c.mu.Unlock()
+	c.misses.Add(1)
+
+	err := invoke(out)
+	if err == nil {
+		f.resp = proto.Clone(out).(*pb.SentimentResponse)
+		c.put(key, f.resp)
+	}
+	f.err = err
+
+	c.mu.Lock()
+	delete(c.flights, key)
+	c.mu.Unlock()
+	close(f.done)
+	return err
+}
+
+// analyzeBatch answers the cached items of req and sends the others to the server
+func (c *resultCache) analyzeBatch(req *pb.SentimentBatchRequest, out *pb.SentimentBatchResponse, invoke func(*pb.SentimentBatchRequest, *pb.SentimentBatchResponse) error) error {
+	keys := make([]CacheKey, len(req.Items))
+	var results []*pb.SentimentBatchResult
+	var missing []int
+
+	for i, item := range req.Items {
+		keys[i] = c.key(item)
+		if resp, ok := c.store.Get(keys[i]); ok {
+			results = append(results, &pb.SentimentBatchResult{
+				Index:   int32(i),
+				Outcome: &pb.SentimentBatchResult_Response{Response: proto.Clone(resp).(*pb.SentimentResponse)},
+			})
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	c.hits.Add(int64(len(results)))
+
+	if len(missing) > 0 {
+		c.misses.Add(int64(len(missing)))
+		sub := &pb.SentimentBatchRequest{Items: make([]*pb.SentimentRequest, len(missing))}
+		for j, i := range missing {
+			sub.Items[j] = req.Items[i]
+		}
+		resp := new(pb.SentimentBatchResponse)
+		if err := invoke(sub, resp); err != nil {
+			return err
+		}
+
+		for _, r := range resp.Results {
+			if r.Index < 0 || int(r.Index) >= len(missing) {
+				// Reported as a missing result by the caller
+				continue
+			}
+			i := missing[r.Index]
+			if item := r.GetResponse(); item != nil {
+				c.put(keys[i], proto.Clone(item).(*pb.SentimentResponse))
+			}
+			r.Index = int32(i)
+			results = append(results, r)
+		}
+	}
+
+	out.Results = results
+	return nil
+}
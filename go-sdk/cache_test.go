@@ -0,0 +1,114 @@
+package go_sdk_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestCacheAnswersRepeatedTexts(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Cache:   &go_sdk.CachePolicy{},
+	})
+	ctx := context.Background()
+
+	for _, text := range []string{"غذا خوب بود", "غذا  خوب بود ", "غذا خوب بود"} {
+		result, err := client.Analyze(ctx, text)
+		if err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+		if !result.IsPositive() {
+			t.Errorf("expected positive result, got %s", result.Sentiment)
+		}
+	}
+	if n := len(srv.Requests()); n != 1 {
+		t.Errorf("expected a single request, got %d", n)
+	}
+
+	// Only the new text of a batch reaches the server
+	results, err := client.AnalyzeBatch(ctx, []string{"غذا خوب بود", "بد بود"})
+	if err != nil {
+		t.Fatalf("AnalyzeBatch() error = %v", err)
+	}
+	if !results[0].IsPositive() || !results[1].IsNegative() {
+		t.Errorf("unexpected batch results %s, %s", results[0].Sentiment, results[1].Sentiment)
+	}
+	if n := srv.Count("بد بود"); n != 1 || len(srv.Requests()) != 2 {
+		t.Errorf("expected only the uncached item to be sent, got %v", srv.Requests())
+	}
+
+	stats := client.CacheStats()
+	if stats.Hits != 3 || stats.Misses != 2 || stats.Entries != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCacheSharesConcurrentCalls(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("کند").After(100 * time.Millisecond)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Cache:   &go_sdk.CachePolicy{},
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Analyze(context.Background(), "کند"); err != nil {
+				t.Errorf("Analyze() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := srv.Count("کند"); n != 1 {
+		t.Errorf("expected concurrent calls to share a request, got %d", n)
+	}
+	if stats := client.CacheStats(); stats.Misses != 1 || stats.Shared != 4 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestCacheExpiresAndFollowsModelVersion(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("خوب").ReturnResponse(&pb.SentimentResponse{Label: "positive", Score: 0.9, ModelVersion: "v2"})
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Cache:   &go_sdk.CachePolicy{TTL: 50 * time.Millisecond},
+	})
+	ctx := context.Background()
+
+	client.Analyze(ctx, "عالی")
+	client.Analyze(ctx, "عالی")
+	if n := srv.Count("عالی"); n != 1 {
+		t.Fatalf("expected a cached result, got %d requests", n)
+	}
+
+	// A response from another model version empties the cache
+	result, err := client.Analyze(ctx, "خوب")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if result.ModelVersion != "v2" {
+		t.Errorf("ModelVersion = %q, want v2", result.ModelVersion)
+	}
+	client.Analyze(ctx, "عالی")
+	if n := srv.Count("عالی"); n != 2 {
+		t.Errorf("expected the result of the old version to be dropped, got %d requests", n)
+	}
+
+	time.Sleep(60 * time.Millisecond)
+	client.Analyze(ctx, "خوب")
+	if n := srv.Count("خوب"); n != 2 {
+		t.Errorf("expected the result to expire, got %d requests", n)
+	}
+}
@@ -2,53 +2,346 @@ package go_sdk
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"time"
 
 	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/internal/telemetry"
+	"github.com/Mannymz/ZenNLP/go-sdk/middleware"
+	"github.com/Mannymz/ZenNLP/go-sdk/normalize"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/credentials/insecure"
-	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
 )
 
 // Client provides a simplified interface to the NLP service
 type Client struct {
-	conn   *grpc.ClientConn
-	client pb.NLPManagerClient
+	conn        *grpc.ClientConn
+	client      pb.NLPManagerClient
+	health      healthpb.HealthClient
+	retry       RetryPolicy
+	instruments *telemetry.Instruments
+	breaker     *circuitBreaker
+	lanes       *lanes
+	hedger      *hedger
+	pool        *pool
+	cache       *resultCache
 }
 
 // Config holds client configuration options
 type Config struct {
-	Address    string
-	Timeout    time.Duration
+	// Address is the gRPC target of the server, e.g. "localhost:50051" or
+	// "dns:///nlp.internal:50051" to balance over every address of a name.
+	Address string
+	// Addresses lists several backends to spread calls over, instead of Address.
+	// Each entry is a target of its own, balanced as above.
+	Addresses []string
+	// Balancer selects how calls are spread over backends and over the
+	// addresses of each target. Defaults to BalancerRoundRobin.
+	Balancer Balancer
+	// PoolSize is the number of connections opened to each backend, to spread
+	// load over more than one HTTP/2 connection. Defaults to 1.
+	PoolSize int
+	// OutlierEjection, when set, takes backends of Addresses that keep failing
+	// out of rotation for a while.
+	OutlierEjection *OutlierEjection
+	// Keepalive sends HTTP/2 pings to detect broken connections while idle or
+	// waiting on slow calls. The server must permit the ping interval.
+	Keepalive *keepalive.ClientParameters
+
+	// Timeout bounds connection setup and is the default deadline of every
+	// unary call whose context has no deadline. Zero disables it.
+	Timeout time.Duration
+	// MaxRetries is the number of retries after the first attempt. It is
+	// ignored when Retry is set.
 	MaxRetries int
+	// Retry is the retry policy applied to every call. When nil,
+	// DefaultRetryPolicy is used with MaxAttempts derived from MaxRetries.
+	Retry *RetryPolicy
+	// DialOptions are appended to the options the client dials with,
+	// e.g. to use a custom dialer in tests.
+	DialOptions []grpc.DialOption
+
+	// UnaryInterceptors wrap every unary call, the first one outermost. They run
+	// around the client's timeout and retry handling, so they observe a single
+	// invocation per call. See the middleware package for common ones.
+	UnaryInterceptors []grpc.UnaryClientInterceptor
+	// StreamInterceptors wrap every streaming call, the first one outermost.
+	StreamInterceptors []grpc.StreamClientInterceptor
+
+	// Normalizer, when set, normalizes every text before it is analyzed or
+	// looked up in the cache, e.g. normalize.New(normalize.Default())
+	Normalizer *normalize.Normalizer
+
+	// TracerProvider records a span for every call, with the text length,
+	// language, label and retry attempts as attributes. Defaults to no tracing.
+	TracerProvider trace.TracerProvider
+	// MeterProvider records call latency and counts by status code and label.
+	// Defaults to no metrics.
+	MeterProvider metric.MeterProvider
+
+	// Insecure disables transport security. Without it the client always uses
+	// TLS, verifying the server against CAFile or the system roots.
+	Insecure bool
+	// CAFile is a PEM bundle of CAs trusted to sign the server certificate.
+	CAFile string
+	// CertFile and KeyFile are the PEM client certificate and key for mutual TLS.
+	CertFile string
+	KeyFile  string
+	// ServerName overrides the host name the server certificate is verified against.
+	ServerName string
+	// TLSConfig is the base TLS configuration the options above are applied to.
+	TLSConfig *tls.Config
+
+	// BearerToken is sent as "authorization: Bearer <token>" on every call.
+	BearerToken string
+	// APIKey is sent as "x-api-key" on every call.
+	APIKey string
+
+	// CircuitBreaker, when set, fails calls fast with ErrCircuitOpen while the
+	// server keeps failing, instead of retrying against it.
+	CircuitBreaker *CircuitBreakerPolicy
+
+	// InteractiveLimits and BulkLimits rate limit and cap the concurrency of
+	// calls of each Priority separately, so bulk traffic never queues
+	// interactive calls. A nil lane is unlimited.
+	InteractiveLimits *LaneLimits
+	BulkLimits        *LaneLimits
+
+	// Cache, when set, answers repeated AnalyzeSentiment calls and batch items
+	// from memory. Concurrent calls for the same text share one request.
+	Cache *CachePolicy
+
+	// Hedging, when set, races a duplicate of slow AnalyzeSentiment calls on a
+	// second connection, or another backend of the pool, to cut tail latency.
+	Hedging *HedgingPolicy
+}
+
+// retryPolicy returns the effective retry policy of the configuration
+func (cfg Config) retryPolicy() RetryPolicy {
+	if cfg.Retry != nil {
+		return cfg.Retry.withDefaults()
+	}
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = cfg.MaxRetries + 1
+	return policy.withDefaults()
+}
+
+// unaryInterceptors returns the user interceptors followed by the built-in ones
+func (c *Client) unaryInterceptors(cfg Config) []grpc.UnaryClientInterceptor {
+	interceptors := append([]grpc.UnaryClientInterceptor(nil), cfg.UnaryInterceptors...)
+	if cfg.Normalizer != nil {
+		interceptors = append(interceptors, middleware.UnaryMutateRequest(normalizeRequests(cfg.Normalizer)))
+	}
+	interceptors = append(interceptors, c.instruments.UnaryClientInterceptor())
+	if c.cache != nil {
+		interceptors = append(interceptors, c.cache.unaryInterceptor())
+	}
+	interceptors = append(interceptors, timeoutInterceptor(cfg.Timeout))
+	if c.hedger != nil {
+		interceptors = append(interceptors, c.hedger.unaryInterceptor())
+	}
+	interceptors = append(interceptors, c.attemptInterceptors()...)
+	if c.pool != nil {
+		interceptors = append(interceptors, c.pool.unaryInterceptor())
+	}
+	return interceptors
+}
+
+// attemptInterceptors returns the interceptors that retry a call and run for
+// every attempt. Hedged requests go through them on their own connection.
+func (c *Client) attemptInterceptors() []grpc.UnaryClientInterceptor {
+	interceptors := []grpc.UnaryClientInterceptor{
+		retryInterceptor(c.retry),
+		telemetry.AttemptInterceptor(),
+	}
+	if c.lanes != nil {
+		interceptors = append(interceptors, c.lanes.unaryInterceptor())
+	}
+	if c.breaker != nil {
+		interceptors = append(interceptors, c.breaker.unaryInterceptor())
+	}
+	return interceptors
 }
 
-// NewClient creates a new NLP client with the given address
+// streamInterceptors returns the user interceptors followed by the built-in ones
+func (c *Client) streamInterceptors(cfg Config) []grpc.StreamClientInterceptor {
+	interceptors := append([]grpc.StreamClientInterceptor(nil), cfg.StreamInterceptors...)
+	if cfg.Normalizer != nil {
+		interceptors = append(interceptors, middleware.StreamMutateRequest(normalizeRequests(cfg.Normalizer)))
+	}
+	interceptors = append(interceptors, c.instruments.StreamClientInterceptor())
+	if c.lanes != nil {
+		interceptors = append(interceptors, c.lanes.streamInterceptor())
+	}
+	if c.breaker != nil {
+		interceptors = append(interceptors, c.breaker.streamInterceptor())
+	}
+	if c.pool != nil {
+		interceptors = append(interceptors, c.pool.streamInterceptor())
+	}
+	return interceptors
+}
+
+// normalizeRequests rewrites the text of every sentiment request
+func normalizeRequests(n *normalize.Normalizer) middleware.RequestMutator {
+	return middleware.SentimentRequests(func(req *pb.SentimentRequest) {
+		req.Text = n.Normalize(req.Text)
+	})
+}
+
+// NewClient creates a new NLP client with the given address.
+// It connects without TLS and is meant for local engines; use
+// NewClientWithConfig to connect securely.
 func NewClient(addr string) (*Client, error) {
 	return NewClientWithConfig(Config{
 		Address:    addr,
 		Timeout:    30 * time.Second,
 		MaxRetries: 3,
+		Insecure:   true,
 	})
 }
 
 // NewClientWithConfig creates a new NLP client with custom configuration
 func NewClientWithConfig(cfg Config) (*Client, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), cfg.Timeout)
-	defer cancel()
+	ctx := context.Background()
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+
+	if cfg.Address != "" && len(cfg.Addresses) > 0 {
+		return nil, fmt.Errorf("invalid config: Address and Addresses are mutually exclusive")
+	}
+	creds, err := cfg.transportCredentials()
+	if err != nil {
+		return nil, err
+	}
 
-	conn, err := grpc.DialContext(ctx, cfg.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	c := &Client{retry: cfg.retryPolicy(), lanes: newLanes(cfg)}
+	c.instruments, err = telemetry.New("client", cfg.TracerProvider, cfg.MeterProvider)
 	if err != nil {
-		return nil, fmt.Errorf("failed to connect to %s: %w", cfg.Address, err)
+		return nil, fmt.Errorf("failed to create telemetry instruments: %w", err)
+	}
+	if cfg.CircuitBreaker != nil {
+		c.breaker = newCircuitBreaker(*cfg.CircuitBreaker)
+	}
+	if cfg.Cache != nil {
+		c.cache = newResultCache(*cfg.Cache)
+	}
+
+	address := cfg.Address
+	if len(cfg.Addresses) > 0 || cfg.PoolSize > 1 {
+		targets := cfg.Addresses
+		if len(targets) == 0 {
+			targets = []string{cfg.Address}
+		}
+		c.pool = newPool(cfg)
+		if err := c.pool.dial(ctx, targets, max(1, cfg.PoolSize), c.dialOptions(cfg, creds)); err != nil {
+			c.Close()
+			return nil, err
+		}
+		address = poolTarget
+	}
+
+	if cfg.Hedging != nil {
+		policy := cfg.Hedging.withDefaults()
+		interceptors := c.attemptInterceptors()
+		hedgeAddress := policy.Address
+		if hedgeAddress == "" {
+			hedgeAddress = address
+			if c.pool != nil {
+				interceptors = append(interceptors, c.pool.unaryInterceptor())
+			}
+		}
+		// A connection of its own, so hedges do not share a transport with the requests they race
+		hedgeConn, err := dial(ctx, hedgeAddress, c.dialOptions(cfg, creds,
+			grpc.WithChainUnaryInterceptor(interceptors...),
+		))
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed to connect to %s: %w", hedgeAddress, err)
+		}
+		c.hedger = &hedger{policy: policy, conn: hedgeConn, instruments: c.instruments}
+	}
+
+	conn, err := dial(ctx, address, c.dialOptions(cfg, creds,
+		grpc.WithChainUnaryInterceptor(c.unaryInterceptors(cfg)...),
+		grpc.WithChainStreamInterceptor(c.streamInterceptors(cfg)...),
+	))
+	if err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to connect to %s: %w", address, err)
+	}
+
+	c.conn = conn
+	c.client = pb.NewNLPManagerClient(conn)
+	c.health = healthpb.NewHealthClient(conn)
+	return c, nil
+}
+
+// dial connects to target. The connection of a pool is created idle, as its
+// calls are sent on the backend connections.
+func dial(ctx context.Context, target string, opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	if target == poolTarget {
+		return grpc.NewClient(target, opts...)
+	}
+	return grpc.DialContext(ctx, target, opts...)
+}
+
+// dialOptions returns the options of a connection with the given interceptors
+func (c *Client) dialOptions(cfg Config, creds credentials.TransportCredentials, interceptors ...grpc.DialOption) []grpc.DialOption {
+	opts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultServiceConfig(serviceConfig(c.retry, cfg.Balancer)),
+	}
+	if cfg.Keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*cfg.Keepalive))
+	}
+	opts = append(opts, interceptors...)
+	if perRPC := cfg.perRPCCredentials(); perRPC != nil {
+		opts = append(opts, grpc.WithPerRPCCredentials(perRPC))
+	}
+	return append(opts, cfg.DialOptions...)
+}
+
+// Backends returns the status of every backend of the pool. It is empty
+// unless Addresses or PoolSize is set.
+func (c *Client) Backends() []BackendStatus {
+	if c.pool == nil {
+		return nil
+	}
+	return c.pool.status()
+}
+
+// CacheStats returns counters of the result cache
+func (c *Client) CacheStats() CacheStats {
+	if c.cache == nil {
+		return CacheStats{}
+	}
+	return c.cache.stats()
+}
+
+// HedgeStats returns counters of the hedging done by the client
+func (c *Client) HedgeStats() HedgeStats {
+	if c.hedger == nil {
+		return HedgeStats{}
 	}
+	return c.hedger.stats()
+}
 
-	client := pb.NewNLPManagerClient(conn)
-	return &Client{
-		conn:   conn,
-		client: client,
-	}, nil
+// CircuitState returns the state of the circuit breaker. Without a
+// configured breaker the circuit is always closed.
+func (c *Client) CircuitState() CircuitState {
+	if c.breaker == nil {
+		return CircuitClosed
+	}
+	return c.breaker.State()
 }
 
 // Analyze performs sentiment analysis on the given text
@@ -68,65 +361,86 @@ func (c *Client) AnalyzeWithLanguage(ctx context.Context, text, lang string) (*R
 		return nil, fmt.Errorf("sentiment analysis failed: %w", err)
 	}
 
-	return &Result{
-		Label: resp.Label,
-		Score: resp.Score,
-	}, nil
+	return newResult(resp), nil
 }
 
-// AnalyzeWithRetry performs sentiment analysis with automatic retries
+// AnalyzeWithRetry performs sentiment analysis retrying up to maxRetries times,
+// overriding the configured number of attempts for this call. It runs in the
+// bulk lane unless ctx has a priority.
 func (c *Client) AnalyzeWithRetry(ctx context.Context, text string, maxRetries int) (*Result, error) {
 	return c.AnalyzeWithLanguageAndRetry(ctx, text, "fa", maxRetries)
 }
 
 // AnalyzeWithLanguageAndRetry performs sentiment analysis with language and automatic retries
 func (c *Client) AnalyzeWithLanguageAndRetry(ctx context.Context, text, lang string, maxRetries int) (*Result, error) {
-	var lastErr error
-
-	for attempt := 0; attempt <= maxRetries; attempt++ {
-		if attempt > 0 {
-			// Exponential backoff
-			backoff := time.Duration(1<<uint(attempt-1)) * time.Second
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(backoff):
-			}
-		}
-
-		result, err := c.AnalyzeWithLanguage(ctx, text, lang)
-		if err == nil {
-			return result, nil
-		}
-
-		lastErr = err
+	ctx = defaultPriority(ctx, PriorityBulk)
+	req := &pb.SentimentRequest{
+		Text: text,
+		Lang: lang,
+	}
 
-		// Don't retry on certain error types
-		if status, ok := status.FromError(err); ok {
-			switch status.Code() {
-			case codes.InvalidArgument, codes.PermissionDenied, codes.Unauthenticated:
-				return nil, err
-			}
+	resp, err := c.client.AnalyzeSentiment(ctx, req, maxAttemptsOption{attempts: maxRetries + 1})
+	if err != nil {
+		if maxRetries > 0 && c.retry.Retryable(err) {
+			return nil, fmt.Errorf("max retries (%d) exceeded: %w", maxRetries, err)
 		}
+		return nil, fmt.Errorf("sentiment analysis failed: %w", err)
 	}
 
-	return nil, fmt.Errorf("max retries (%d) exceeded: %w", maxRetries, lastErr)
+	return newResult(resp), nil
 }
 
 // Result represents the sentiment analysis result
 type Result struct {
-	Label string
-	Score float64
+	Label     string
+	Score     float64
+	Sentiment Sentiment
+	// ModelVersion identifies the model that produced the result, if the engine reports it
+	ModelVersion string
+
+	probabilities map[Sentiment]float64
+}
+
+// newResult converts a wire response into a Result
+func newResult(resp *pb.SentimentResponse) *Result {
+	sentiment := sentimentFromProto(resp.Sentiment)
+	if sentiment == SentimentUnknown {
+		// Engines predating the typed label only fill in the string
+		sentiment = ParseSentiment(resp.Label)
+	}
+
+	probabilities := make(map[Sentiment]float64, len(resp.Probabilities))
+	for label, p := range resp.Probabilities {
+		if s := ParseSentiment(label); s != SentimentUnknown {
+			probabilities[s] = p
+		}
+	}
+	if len(probabilities) == 0 && sentiment != SentimentUnknown {
+		probabilities[sentiment] = resp.Score
+	}
+
+	return &Result{
+		Label:         resp.Label,
+		Score:         resp.Score,
+		Sentiment:     sentiment,
+		ModelVersion:  resp.ModelVersion,
+		probabilities: probabilities,
+	}
 }
 
 // IsPositive returns true if the sentiment is positive
 func (r *Result) IsPositive() bool {
-	return r.Label == "positive"
+	return r.Sentiment == SentimentPositive
 }
 
 // IsNegative returns true if the sentiment is negative
 func (r *Result) IsNegative() bool {
-	return r.Label == "negative"
+	return r.Sentiment == SentimentNegative
+}
+
+// IsNeutral returns true if the sentiment is neutral
+func (r *Result) IsNeutral() bool {
+	return r.Sentiment == SentimentNeutral
 }
 
 // Confidence returns the confidence score as a percentage
@@ -134,7 +448,47 @@ func (r *Result) Confidence() float64 {
 	return r.Score * 100
 }
 
+// Probabilities returns the probability of every class reported by the engine.
+// Engines that only report the winning class yield a single entry.
+func (r *Result) Probabilities() map[Sentiment]float64 {
+	out := make(map[Sentiment]float64, len(r.probabilities))
+	for s, p := range r.probabilities {
+		out[s] = p
+	}
+	return out
+}
+
+// Probability returns the probability of the given class, or 0 if it was not reported
+func (r *Result) Probability(s Sentiment) float64 {
+	return r.probabilities[s]
+}
+
+// Margin returns the difference between the two most likely classes.
+// A small margin means the model was torn between them.
+func (r *Result) Margin() float64 {
+	var first, second float64
+	for _, p := range r.probabilities {
+		switch {
+		case p > first:
+			first, second = p, first
+		case p > second:
+			second = p
+		}
+	}
+	return first - second
+}
+
 // Close closes the client connection
 func (c *Client) Close() error {
-	return c.conn.Close()
+	var err error
+	if c.conn != nil {
+		err = c.conn.Close()
+	}
+	if c.hedger != nil {
+		c.hedger.conn.Close()
+	}
+	if c.pool != nil {
+		c.pool.close()
+	}
+	return err
 }
@@ -0,0 +1,141 @@
+package go_sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/normalize"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAnalyzeRetriesRetryableErrors(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("عالی بود").Fail(codes.Unavailable, "engine restarting").Times(2)
+	client := srv.Client(t)
+
+	result, err := client.Analyze(context.Background(), "عالی بود")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if !result.IsPositive() {
+		t.Errorf("expected positive result, got %s", result.Sentiment)
+	}
+	if n := srv.Count("عالی بود"); n != 3 {
+		t.Errorf("expected 3 attempts, got %d", n)
+	}
+}
+
+func TestAnalyzeDoesNotRetryNonRetryableErrors(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("متن").Fail(codes.InvalidArgument, "bad text")
+	client := srv.Client(t)
+
+	_, err := client.Analyze(context.Background(), "متن")
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected InvalidArgument, got %v", err)
+	}
+	if n := srv.Count("متن"); n != 1 {
+		t.Errorf("expected 1 attempt, got %d", n)
+	}
+}
+
+func TestAnalyzeWithRetryOverridesMaxAttempts(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("متن").Fail(codes.Unavailable, "down")
+	client := srv.Client(t)
+
+	_, err := client.AnalyzeWithRetry(context.Background(), "متن", 1)
+	if status.Code(err) != codes.Unavailable {
+		t.Fatalf("expected Unavailable, got %v", err)
+	}
+	if n := srv.Count("متن"); n != 2 {
+		t.Errorf("expected 2 attempts, got %d", n)
+	}
+}
+
+func TestRetryBackoffEndsWithStatusError(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("متن").Fail(codes.Unavailable, "down")
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Retry: &go_sdk.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Minute},
+	})
+
+	// The deadline passes while waiting for the second attempt
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err := client.Analyze(ctx, "متن")
+	if s, ok := status.FromError(errors.Unwrap(err)); !ok || s.Code() != codes.DeadlineExceeded {
+		t.Fatalf("expected a DeadlineExceeded status error, got %v", err)
+	}
+	if n := srv.Count("متن"); n != 1 {
+		t.Errorf("expected 1 attempt, got %d", n)
+	}
+}
+
+func TestConfigTimeoutIsDefaultDeadline(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("کند").After(time.Second)
+	client := srv.ClientWithConfig(t, go_sdk.Config{Timeout: 50 * time.Millisecond})
+
+	start := time.Now()
+	_, err := client.Analyze(context.Background(), "کند")
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("call took %s, expected the configured timeout to apply", elapsed)
+	}
+}
+
+func TestResultProbabilities(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.Client(t)
+
+	result, err := client.Analyze(context.Background(), "غذا عالی بود")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	probs := result.Probabilities()
+	if len(probs) < 2 {
+		t.Fatalf("expected a distribution over several classes, got %v", probs)
+	}
+	if probs[go_sdk.SentimentPositive] != result.Score {
+		t.Errorf("positive probability %f should equal score %f", probs[go_sdk.SentimentPositive], result.Score)
+	}
+	if margin := result.Margin(); margin <= 0 || margin > 1 {
+		t.Errorf("margin should be in (0, 1], got %f", margin)
+	}
+}
+
+func TestNormalizer(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:    5 * time.Second,
+		Normalizer: normalize.New(normalize.Default()),
+	})
+	ctx := context.Background()
+
+	if _, err := client.Analyze(ctx, "  غذاها خيلي  خوب بود "); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if _, err := client.AnalyzeBatch(ctx, []string{"كيك ها عالي بود"}); err != nil {
+		t.Fatalf("AnalyzeBatch() error = %v", err)
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(requests))
+	}
+	if requests[0].Text != "غذاها خیلی خوب بود" {
+		t.Errorf("sent %q", requests[0].Text)
+	}
+	if requests[1].Text != "کیک‌ها عالی بود" {
+		t.Errorf("sent %q", requests[1].Text)
+	}
+}
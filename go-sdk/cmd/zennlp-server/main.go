@@ -0,0 +1,244 @@
+// Command zennlp-server serves the NLPManager gRPC service using the pure Go
+// lexicon scorer, as a drop-in replacement for the Python engine. With
+// -upstream it runs as a gateway instead, forwarding every call to the Python
+// engine and adding TLS, authentication and Prometheus metrics in front of it.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func main() {
+	port := flag.Int("port", envInt("ZENNLP_PORT", 50051), "gRPC port to listen on")
+	host := flag.String("host", os.Getenv("ZENNLP_HOST"), "interface to listen on (all interfaces when empty)")
+	shutdownTimeout := flag.Duration("shutdown-timeout", 10*time.Second, "time to wait for in-flight requests on shutdown")
+	certFile := flag.String("tls-cert", os.Getenv("ZENNLP_TLS_CERT"), "PEM server certificate; serves plain text when empty")
+	keyFile := flag.String("tls-key", os.Getenv("ZENNLP_TLS_KEY"), "PEM server private key")
+	clientCA := flag.String("client-ca", os.Getenv("ZENNLP_CLIENT_CA"), "PEM CA bundle; requires client certificates (mTLS) when set")
+	tokens := flag.String("tokens", os.Getenv("ZENNLP_TOKENS"), "comma separated bearer tokens accepted from clients")
+	apiKeys := flag.String("api-keys", os.Getenv("ZENNLP_API_KEYS"), "comma separated API keys accepted from clients")
+	metricsAddr := flag.String("metrics-addr", envString("ZENNLP_METRICS_ADDR", ":9090"), "address serving Prometheus metrics on /metrics; disabled when empty")
+	upstreamAddr := flag.String("upstream", os.Getenv("ZENNLP_UPSTREAM"), "address of an NLPManager engine to forward calls to instead of scoring locally")
+	gazetteerFile := flag.String("gazetteer", os.Getenv("ZENNLP_GAZETTEER"), "file of extra names for entity extraction, one TYPE<tab>name per line")
+	taxonomyFile := flag.String("aspects", os.Getenv("ZENNLP_ASPECTS"), "file of extra aspect terms for aspect-based sentiment, one category<tab>term per line")
+	keepaliveMinTime := flag.Duration("keepalive-min-time", envDuration("ZENNLP_KEEPALIVE_MIN_TIME", 0), "shortest client keepalive ping interval accepted (gRPC default of 5m when zero)")
+	probeAddr := flag.String("health-probe", "", "check the health of the plain-text server at this address and exit, for container health checks")
+	flag.Parse()
+
+	if *probeAddr != "" {
+		os.Exit(probe(*probeAddr))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	metrics := server.NewMetrics()
+	healthServer := health.NewServer()
+	setServing := func(serving bool) {
+		server.SetServing(healthServer, serving)
+		metrics.SetModelLoaded(serving)
+	}
+
+	recognizer, err := loadRecognizer(*gazetteerFile)
+	if err != nil {
+		log.Fatalf("Failed to load gazetteer: %v", err)
+	}
+	aspects, err := loadAspectAnalyzer(*taxonomyFile)
+	if err != nil {
+		log.Fatalf("Failed to load aspect taxonomy: %v", err)
+	}
+
+	var service server.Service
+	if *upstreamAddr != "" {
+		conn, err := grpc.NewClient(*upstreamAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			log.Fatalf("Failed to create upstream client for %s: %v", *upstreamAddr, err)
+		}
+		defer conn.Close()
+		setServing(false)
+		go server.WatchUpstream(ctx, conn, setServing)
+		service = server.NewProxy(pb.NewNLPManagerClient(conn)).WithRecognizer(recognizer).WithAspectAnalyzer(aspects)
+		log.Printf("Forwarding calls to %s", *upstreamAddr)
+	} else {
+		service = server.New().WithRecognizer(recognizer).WithAspectAnalyzer(aspects)
+		setServing(true)
+	}
+
+	addr := net.JoinHostPort(*host, fmt.Sprint(*port))
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", addr, err)
+	}
+
+	grpcServer, err := server.NewGRPCServer(service, server.Options{
+		CertFile:     *certFile,
+		KeyFile:      *keyFile,
+		ClientCAFile: *clientCA,
+		BearerTokens: splitList(*tokens),
+		APIKeys:      splitList(*apiKeys),
+		Health:       healthServer,
+		Metrics:      metrics,
+
+		KeepaliveMinTime: *keepaliveMinTime,
+	})
+	if err != nil {
+		log.Fatalf("Failed to create server: %v", err)
+	}
+	if *certFile == "" {
+		log.Printf("Warning: TLS is disabled, serving plain text")
+	}
+
+	var metricsServer *http.Server
+	if *metricsAddr != "" {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+		metricsServer = &http.Server{Addr: *metricsAddr, Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+		go func() {
+			log.Printf("Serving metrics on %s/metrics", *metricsAddr)
+			if err := metricsServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				log.Fatalf("Metrics server failed: %v", err)
+			}
+		}()
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		log.Printf("Shutting down, waiting up to %s for in-flight requests", *shutdownTimeout)
+		healthServer.Shutdown()
+
+		done := make(chan struct{})
+		go func() {
+			grpcServer.GracefulStop()
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(*shutdownTimeout):
+			log.Printf("Graceful shutdown timed out, closing remaining connections")
+			grpcServer.Stop()
+		}
+
+		if metricsServer != nil {
+			metricsServer.Close()
+		}
+	}()
+
+	log.Printf("Starting gRPC server on %s", lis.Addr())
+	if err := grpcServer.Serve(lis); err != nil {
+		log.Fatalf("Server failed: %v", err)
+	}
+
+	// Serve returns as soon as the listener closes; wait for in-flight requests to drain
+	<-stopped
+	log.Printf("Server stopped")
+}
+
+// probe returns 0 when the NLPManager service at addr reports SERVING
+func probe(addr string) int {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Printf("Failed to create client for %s: %v", addr, err)
+		return 1
+	}
+	defer conn.Close()
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: server.ServiceName})
+	if err != nil {
+		log.Printf("Health check failed: %v", err)
+		return 1
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		log.Printf("Service is %s", resp.Status)
+		return 1
+	}
+	return 0
+}
+
+// loadRecognizer extends the default gazetteer with the names of path, if set
+func loadRecognizer(path string) (*server.GazetteerRecognizer, error) {
+	g := server.DefaultGazetteer()
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if err := g.Load(f); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return server.NewGazetteerRecognizer(g), nil
+}
+
+// loadAspectAnalyzer extends the default taxonomy with the terms of path, if set
+func loadAspectAnalyzer(path string) (*server.LexiconAspectAnalyzer, error) {
+	t := server.DefaultTaxonomy()
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		if err := t.Load(f); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	}
+	return server.NewLexiconAspectAnalyzer(t), nil
+}
+
+func envString(key, def string) string {
+	if v, ok := os.LookupEnv(key); ok {
+		return v
+	}
+	return def
+}
+
+func envDuration(key string, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(os.Getenv(key))
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+func envInt(key string, def int) int {
+	var v int
+	if _, err := fmt.Sscan(os.Getenv(key), &v); err != nil {
+		return def
+	}
+	return v
+}
+
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,92 @@
+package go_sdk
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// transportCredentials builds TLS credentials from the configuration, or
+// insecure credentials when explicitly requested
+func (cfg Config) transportCredentials() (credentials.TransportCredentials, error) {
+	hasTLS := cfg.CAFile != "" || cfg.CertFile != "" || cfg.KeyFile != "" || cfg.ServerName != "" || cfg.TLSConfig != nil
+	if cfg.Insecure {
+		if hasTLS {
+			return nil, fmt.Errorf("invalid config: Insecure cannot be combined with TLS options")
+		}
+		return insecure.NewCredentials(), nil
+	}
+
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg.TLSConfig != nil {
+		tlsCfg = cfg.TLSConfig.Clone()
+	}
+
+	if cfg.CAFile != "" {
+		pem, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to parse CA file %s: no certificates found", cfg.CAFile)
+		}
+		tlsCfg.RootCAs = pool
+	}
+
+	if cfg.CertFile != "" || cfg.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsCfg.Certificates = append(tlsCfg.Certificates, cert)
+	}
+
+	if cfg.ServerName != "" {
+		tlsCfg.ServerName = cfg.ServerName
+	}
+
+	return credentials.NewTLS(tlsCfg), nil
+}
+
+// perRPCCredentials returns the credentials attached to every call, if any
+func (cfg Config) perRPCCredentials() credentials.PerRPCCredentials {
+	if cfg.BearerToken == "" && cfg.APIKey == "" {
+		return nil
+	}
+	return metadataCredentials{
+		token:         cfg.BearerToken,
+		apiKey:        cfg.APIKey,
+		allowInsecure: cfg.Insecure,
+	}
+}
+
+// metadataCredentials attaches a bearer token and/or API key as request metadata
+type metadataCredentials struct {
+	token         string
+	apiKey        string
+	allowInsecure bool
+}
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (m metadataCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	md := make(map[string]string, 2)
+	if m.token != "" {
+		md["authorization"] = "Bearer " + m.token
+	}
+	if m.apiKey != "" {
+		md["x-api-key"] = m.apiKey
+	}
+	return md, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials. Secrets are
+// only sent in plain text when the configuration explicitly opted into Insecure.
+func (m metadataCredentials) RequireTransportSecurity() bool {
+	return !m.allowInsecure
+}
@@ -0,0 +1,93 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// EntityType is the kind of a named entity
+type EntityType int
+
+const (
+	// EntityUnknown is used for types the SDK does not know
+	EntityUnknown EntityType = iota
+	EntityPerson
+	EntityLocation
+	EntityOrganization
+	EntityProduct
+	EntityDate
+	EntityMoney
+)
+
+var entityTypeNames = map[EntityType]string{
+	EntityUnknown:      "UNKNOWN",
+	EntityPerson:       "PER",
+	EntityLocation:     "LOC",
+	EntityOrganization: "ORG",
+	EntityProduct:      "PRODUCT",
+	EntityDate:         "DATE",
+	EntityMoney:        "MONEY",
+}
+
+// String returns the tag of the type, e.g. "PER" or "LOC"
+func (t EntityType) String() string {
+	if name, ok := entityTypeNames[t]; ok {
+		return name
+	}
+	return entityTypeNames[EntityUnknown]
+}
+
+func entityTypeFromProto(t pb.EntityType) EntityType {
+	switch t {
+	case pb.EntityType_ENTITY_TYPE_PER:
+		return EntityPerson
+	case pb.EntityType_ENTITY_TYPE_LOC:
+		return EntityLocation
+	case pb.EntityType_ENTITY_TYPE_ORG:
+		return EntityOrganization
+	case pb.EntityType_ENTITY_TYPE_PRODUCT:
+		return EntityProduct
+	case pb.EntityType_ENTITY_TYPE_DATE:
+		return EntityDate
+	case pb.EntityType_ENTITY_TYPE_MONEY:
+		return EntityMoney
+	default:
+		return EntityUnknown
+	}
+}
+
+// Entity is a named entity found in a text
+type Entity struct {
+	Text string
+	Type EntityType
+	// Start and End are the offsets of the entity in the text in runes, End exclusive
+	Start, End int
+	// Confidence is between 0 and 1
+	Confidence float64
+}
+
+// Entities returns the people, places, organizations, products, dates and
+// amounts of money mentioned in text, in order
+func (c *Client) Entities(ctx context.Context, text string) ([]Entity, error) {
+	resp, err := c.client.ExtractEntities(ctx, &pb.ExtractEntitiesRequest{
+		Text: text,
+		Lang: "fa",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("entity extraction failed: %w", err)
+	}
+
+	entities := make([]Entity, len(resp.Entities))
+	for i, e := range resp.Entities {
+		entities[i] = Entity{
+			Text:       e.Text,
+			Type:       entityTypeFromProto(e.Type),
+			Start:      int(e.Start),
+			End:        int(e.End),
+			Confidence: e.Confidence,
+		}
+	}
+	return entities, nil
+}
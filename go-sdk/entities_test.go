@@ -0,0 +1,44 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestEntities(t *testing.T) {
+	client := zennlptest.NewServer(t).Client(t)
+
+	text := "سفارش دیروز از اسنپ‌فود به تهران، ۲۵۰ هزار تومان"
+	entities, err := client.Entities(context.Background(), text)
+	if err != nil {
+		t.Fatalf("Entities() error = %v", err)
+	}
+
+	want := []struct {
+		text string
+		typ  go_sdk.EntityType
+	}{
+		{"دیروز", go_sdk.EntityDate},
+		{"اسنپ‌فود", go_sdk.EntityOrganization},
+		{"تهران", go_sdk.EntityLocation},
+		{"۲۵۰ هزار تومان", go_sdk.EntityMoney},
+	}
+	if len(entities) != len(want) {
+		t.Fatalf("Entities() = %v, want %d entities", entities, len(want))
+	}
+	runes := []rune(text)
+	for i, e := range entities {
+		if e.Text != want[i].text || e.Type != want[i].typ {
+			t.Errorf("entity %d = %q %s, want %q %s", i, e.Text, e.Type, want[i].text, want[i].typ)
+		}
+		if string(runes[e.Start:e.End]) != e.Text {
+			t.Errorf("offsets of %q select %q", e.Text, string(runes[e.Start:e.End]))
+		}
+		if e.Confidence <= 0 || e.Confidence > 1 {
+			t.Errorf("confidence of %q = %v", e.Text, e.Confidence)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package go_sdk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultConcurrency is the number of workers AnalyzeAll uses when none is configured
+const DefaultConcurrency = 8
+
+// AnalyzeAllOptions controls how AnalyzeAll fans requests out
+type AnalyzeAllOptions struct {
+	// Concurrency is the maximum number of requests in flight. Defaults to DefaultConcurrency.
+	Concurrency int
+	// ItemTimeout bounds each individual request. Zero means only ctx applies.
+	ItemTimeout time.Duration
+	// Lang is the language of every text. Defaults to "fa".
+	Lang string
+}
+
+// IndexedResult is the outcome for the text at Index in the input slice
+type IndexedResult struct {
+	Index  int
+	Result *Result
+	Err    error
+}
+
+// AnalyzeAll analyzes texts concurrently with a bounded pool of workers and
+// delivers results on the returned channel in completion order. The channel is
+// closed once every text has been processed or ctx is done; texts that were not
+// started before cancellation are not reported. Calls run at PriorityBulk unless
+// ctx sets a priority.
+func (c *Client) AnalyzeAll(ctx context.Context, texts []string, opts AnalyzeAllOptions) <-chan IndexedResult {
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	if concurrency > len(texts) {
+		concurrency = len(texts)
+	}
+	lang := opts.Lang
+	if lang == "" {
+		lang = "fa"
+	}
+	ctx = defaultPriority(ctx, PriorityBulk)
+
+	out := make(chan IndexedResult, concurrency)
+	indexes := make(chan int)
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range indexes {
+				if ctx.Err() != nil {
+					return
+				}
+				res := IndexedResult{Index: idx}
+				res.Result, res.Err = c.analyzeItem(ctx, texts[idx], lang, opts.ItemTimeout)
+
+				select {
+				case out <- res:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer close(out)
+		defer wg.Wait()
+		defer close(indexes)
+
+		for idx := range texts {
+			select {
+			case indexes <- idx:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
+}
+
+// AnalyzeAllFunc is like AnalyzeAll but invokes fn for every result instead of
+// returning a channel. It returns once all results are delivered or ctx is done.
+func (c *Client) AnalyzeAllFunc(ctx context.Context, texts []string, opts AnalyzeAllOptions, fn func(IndexedResult)) error {
+	for res := range c.AnalyzeAll(ctx, texts, opts) {
+		fn(res)
+	}
+	return ctx.Err()
+}
+
+func (c *Client) analyzeItem(ctx context.Context, text, lang string, timeout time.Duration) (*Result, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return c.AnalyzeWithLanguage(ctx, text, lang)
+}
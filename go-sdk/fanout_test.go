@@ -0,0 +1,69 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAnalyzeAllAppliesItemTimeout(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("کند").After(time.Second)
+	client := srv.Client(t)
+
+	texts := []string{"عالی", "کند", "بد", "خوب"}
+	opts := go_sdk.AnalyzeAllOptions{Concurrency: 2, ItemTimeout: 50 * time.Millisecond}
+
+	seen := map[int]bool{}
+	for res := range client.AnalyzeAll(context.Background(), texts, opts) {
+		seen[res.Index] = true
+		if texts[res.Index] == "کند" {
+			if status.Code(res.Err) != codes.DeadlineExceeded {
+				t.Errorf("expected slow item to time out, got %v", res.Err)
+			}
+			continue
+		}
+		if res.Err != nil {
+			t.Errorf("item %d failed: %v", res.Index, res.Err)
+		}
+	}
+
+	if len(seen) != len(texts) {
+		t.Errorf("expected %d results, got %d", len(texts), len(seen))
+	}
+}
+
+func TestAnalyzeAllStopsOnCancel(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().After(time.Second)
+	client := srv.Client(t)
+
+	texts := make([]string, 100)
+	for i := range texts {
+		texts[i] = "متن"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range client.AnalyzeAll(ctx, texts, go_sdk.AnalyzeAllOptions{Concurrency: 4}) {
+		}
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("AnalyzeAll did not stop after cancellation")
+	}
+	if n := len(srv.Requests()); n > 4 {
+		t.Errorf("expected at most 4 requests to start, got %d", n)
+	}
+}
@@ -0,0 +1,272 @@
+package go_sdk
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// cacheRecord is a line of a JSONL results file
+type cacheRecord struct {
+	Text          string             `json:"text"`
+	Lang          string             `json:"lang,omitempty"`
+	ModelVersion  string             `json:"model_version,omitempty"`
+	Label         string             `json:"label"`
+	Score         float64            `json:"score"`
+	Probabilities map[string]float64 `json:"probabilities,omitempty"`
+}
+
+func newCacheRecord(key CacheKey, resp *pb.SentimentResponse) cacheRecord {
+	return cacheRecord{
+		Text:          key.Text,
+		Lang:          key.Lang,
+		ModelVersion:  key.ModelVersion,
+		Label:         resp.Label,
+		Score:         resp.Score,
+		Probabilities: resp.Probabilities,
+	}
+}
+
+func (r cacheRecord) key() CacheKey {
+	return NewCacheKey(r.Text, r.Lang, r.ModelVersion)
+}
+
+func (r cacheRecord) response() *pb.SentimentResponse {
+	return &pb.SentimentResponse{
+		Label:         r.Label,
+		Score:         r.Score,
+		Sentiment:     ParseSentiment(r.Label).proto(),
+		Probabilities: r.Probabilities,
+		ModelVersion:  r.ModelVersion,
+	}
+}
+
+// readRecords calls fn for every valid record of a JSONL stream and returns
+// the number of lines that could not be parsed
+func readRecords(r io.Reader, fn func(cacheRecord)) (invalid int, err error) {
+	br := bufio.NewReader(r)
+	for {
+		line, err := br.ReadBytes('\n')
+		if len(line) > 0 {
+			var rec cacheRecord
+			if json.Unmarshal(line, &rec) != nil || rec.Text == "" || rec.Label == "" {
+				invalid++
+			} else if err == nil {
+				fn(rec)
+			} else {
+				// A complete JSON object without its newline, e.g. the last line
+				// written before a crash
+				fn(rec)
+				invalid++
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			return invalid, nil
+		}
+		if err != nil {
+			return invalid, err
+		}
+	}
+}
+
+// WarmCache stores the results of a JSONL stream in cache and returns how many
+// were stored. Each line is an object with "text", "lang", "model_version",
+// "label", "score" and "probabilities", as written by FileCache. Lines that
+// cannot be parsed are skipped.
+func WarmCache(cache Cache, r io.Reader) (int, error) {
+	n := 0
+	_, err := readRecords(r, func(rec cacheRecord) {
+		cache.Put(rec.key(), rec.response())
+		n++
+	})
+	if err != nil {
+		return n, fmt.Errorf("failed to read cache records: %w", err)
+	}
+	return n, nil
+}
+
+// FileCache is a Cache persisted to an append-only JSONL file, so results
+// survive restarts. Every result is also kept in memory; results do not expire
+// and are only dropped when the model version changes.
+type FileCache struct {
+	path string
+
+	mu      sync.Mutex
+	file    *os.File
+	entries map[CacheKey]*pb.SentimentResponse
+	version string
+	lines   int
+	err     error
+}
+
+// OpenFileCache loads the results stored at path, creating the file if needed.
+// A file with damaged lines, e.g. after a crash, is rewritten without them.
+func OpenFileCache(path string) (*FileCache, error) {
+	c := &FileCache{path: path, entries: make(map[CacheKey]*pb.SentimentResponse)}
+
+	invalid := 0
+	if f, err := os.Open(path); err == nil {
+		invalid, err = readRecords(f, func(rec cacheRecord) {
+			c.entries[rec.key()] = rec.response()
+			c.version = rec.ModelVersion
+			c.lines++
+		})
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read cache file %s: %w", path, err)
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to open cache file %s: %w", path, err)
+	}
+
+	if invalid > 0 || c.lines > 2*len(c.entries) {
+		if err := c.compactLocked(); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open cache file %s: %w", path, err)
+	}
+	c.file = f
+	return c, nil
+}
+
+// Get implements Cache
+func (c *FileCache) Get(key CacheKey) (*pb.SentimentResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	resp, ok := c.entries[key]
+	return resp, ok
+}
+
+// Put implements Cache. Write errors are reported by Close.
+func (c *FileCache) Put(key CacheKey, resp *pb.SentimentResponse) {
+	line, err := json.Marshal(newCacheRecord(key, resp))
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = resp
+	c.version = key.ModelVersion
+	if c.err != nil || c.file == nil {
+		return
+	}
+	if _, err := c.file.Write(append(line, '\n')); err != nil {
+		c.err = fmt.Errorf("failed to write cache file %s: %w", c.path, err)
+		return
+	}
+	c.lines++
+}
+
+// Invalidate implements Cache. The file is rewritten without the dropped results.
+func (c *FileCache) Invalidate(current string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.version = current
+	dropped := false
+	for key := range c.entries {
+		if key.ModelVersion != current {
+			delete(c.entries, key)
+			dropped = true
+		}
+	}
+	if dropped && c.err == nil && c.file != nil {
+		c.err = c.compactLocked()
+	}
+}
+
+// ModelVersion returns the model version of the latest stored result
+func (c *FileCache) ModelVersion() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.version
+}
+
+// Len implements Cache
+func (c *FileCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Compact rewrites the file with a single line per result
+func (c *FileCache) Compact() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	return c.compactLocked()
+}
+
+// Close closes the file and returns the first error met while writing it
+func (c *FileCache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.file == nil {
+		return c.err
+	}
+	err := c.file.Close()
+	c.file = nil
+	if c.err != nil {
+		return c.err
+	}
+	return err
+}
+
+// compactLocked writes the entries to a new file and replaces the old one
+// with it, so a crash leaves either of them intact
+func (c *FileCache) compactLocked() error {
+	tmp := c.path + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to compact cache file %s: %w", c.path, err)
+	}
+
+	w := bufio.NewWriter(f)
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	for key, resp := range c.entries {
+		if err = enc.Encode(newCacheRecord(key, resp)); err != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmp, c.path)
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("failed to compact cache file %s: %w", c.path, err)
+	}
+
+	if c.file != nil {
+		c.file.Close()
+	}
+	c.file, err = os.OpenFile(c.path, os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open cache file %s: %w", c.path, err)
+	}
+	c.lines = len(c.entries)
+	return nil
+}
@@ -0,0 +1,129 @@
+package go_sdk_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/server"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestFileCacheSurvivesRestart(t *testing.T) {
+	tests := []struct {
+		name    string
+		version string
+	}{
+		{"pinned version", server.LexiconModelVersion},
+		// Lookups use the stored version until the engine reports one
+		{"followed version", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "results.jsonl")
+			ctx := context.Background()
+
+			run := func() *zennlptest.Server {
+				store, err := go_sdk.OpenFileCache(path)
+				if err != nil {
+					t.Fatalf("OpenFileCache() error = %v", err)
+				}
+				srv := zennlptest.NewServer(t)
+				client := srv.ClientWithConfig(t, go_sdk.Config{
+					Timeout: 5 * time.Second,
+					Cache:   &go_sdk.CachePolicy{Store: store, ModelVersion: tt.version},
+				})
+				result, err := client.Analyze(ctx, "خیلی خوب بود")
+				if err != nil {
+					t.Fatalf("Analyze() error = %v", err)
+				}
+				if !result.IsPositive() {
+					t.Errorf("expected positive result, got %s", result.Sentiment)
+				}
+				if err := store.Close(); err != nil {
+					t.Fatalf("Close() error = %v", err)
+				}
+				return srv
+			}
+
+			if n := len(run().Requests()); n != 1 {
+				t.Fatalf("expected the first run to reach the server, got %d requests", n)
+			}
+			if n := len(run().Requests()); n != 0 {
+				t.Errorf("expected the second run to be answered from the file, got %d requests", n)
+			}
+		})
+	}
+}
+
+func TestFileCacheInvalidatesOtherVersions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "results.jsonl")
+	lines := `{"text":"خوب","model_version":"v1","label":"positive","score":0.9}
+{"text":"بد","model_version":"v2","label":"negative","score":0.8}
+{"text":"ناقص","model_ver`
+	if err := os.WriteFile(path, []byte(lines), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err := go_sdk.OpenFileCache(path)
+	if err != nil {
+		t.Fatalf("OpenFileCache() error = %v", err)
+	}
+	if n := store.Len(); n != 2 {
+		t.Fatalf("expected the damaged line to be skipped, got %d results", n)
+	}
+	store.Invalidate("v2")
+	if _, ok := store.Get(go_sdk.NewCacheKey("خوب", "", "v1")); ok {
+		t.Error("expected the v1 result to be dropped")
+	}
+	if err := store.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	store, err = go_sdk.OpenFileCache(path)
+	if err != nil {
+		t.Fatalf("OpenFileCache() error = %v", err)
+	}
+	defer store.Close()
+	resp, ok := store.Get(go_sdk.NewCacheKey("بد", "fa", "v2"))
+	if !ok || store.Len() != 1 {
+		t.Fatalf("expected only the v2 result after reopening, got %d results", store.Len())
+	}
+	if resp.Label != "negative" || resp.Score != 0.8 {
+		t.Errorf("unexpected result %v", resp)
+	}
+}
+
+func TestWarmCache(t *testing.T) {
+	store := go_sdk.NewMemoryCache(0, 0)
+	n, err := go_sdk.WarmCache(store, strings.NewReader(`{"text":"غذا  سرد بود","lang":"fa","model_version":"v1","label":"negative","score":0.7}
+not json
+{"text":"عالی","model_version":"v1","label":"positive","score":0.95,"probabilities":{"positive":0.95,"negative":0.05}}
+`))
+	if err != nil {
+		t.Fatalf("WarmCache() error = %v", err)
+	}
+	if n != 2 {
+		t.Fatalf("WarmCache() = %d, want 2", n)
+	}
+
+	srv := zennlptest.NewServer(t)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Cache:   &go_sdk.CachePolicy{Store: store, ModelVersion: "v1"},
+	})
+	result, err := client.Analyze(context.Background(), "غذا سرد بود")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if !result.IsNegative() || result.Score != 0.7 {
+		t.Errorf("expected the pre-warmed result, got %s %.2f", result.Sentiment, result.Score)
+	}
+	if n := len(srv.Requests()); n != 0 {
+		t.Errorf("expected no requests, got %d", n)
+	}
+}
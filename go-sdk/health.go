@@ -0,0 +1,71 @@
+package go_sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ErrNotServing is returned by Health when the server is reachable but does not
+// serve sentiment analysis yet, e.g. while the engine is loading its model
+var ErrNotServing = errors.New("nlp service is not serving")
+
+// Health checks whether the server reports the NLPManager service as serving
+func (c *Client) Health(ctx context.Context) error {
+	resp, err := c.health.Check(ctx, &healthpb.HealthCheckRequest{Service: pb.NLPManager_ServiceDesc.ServiceName})
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	if resp.Status != healthpb.HealthCheckResponse_SERVING {
+		return fmt.Errorf("%w: %s", ErrNotServing, resp.Status)
+	}
+	return nil
+}
+
+// WaitForReady blocks until the server reports the NLPManager service as
+// serving or ctx is done. Unreachable servers and dropped watches are retried
+// with the backoff of the client's retry policy.
+func (c *Client) WaitForReady(ctx context.Context) error {
+	for attempt := 1; ; attempt++ {
+		err := c.watchUntilServing(ctx)
+		if err == nil {
+			return nil
+		}
+		if status.Code(err) == codes.Unimplemented {
+			return fmt.Errorf("server does not support health checks: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("server not ready: %w", err)
+		case <-time.After(c.retry.Backoff(attempt)):
+		}
+	}
+}
+
+// watchUntilServing watches the health of the service until it is serving
+func (c *Client) watchUntilServing(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.health.Watch(ctx, &healthpb.HealthCheckRequest{Service: pb.NLPManager_ServiceDesc.ServiceName}, grpc.WaitForReady(true))
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		if resp.Status == healthpb.HealthCheckResponse_SERVING {
+			return nil
+		}
+	}
+}
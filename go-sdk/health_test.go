@@ -0,0 +1,48 @@
+package go_sdk_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestHealth(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.Client(t)
+	ctx := context.Background()
+
+	if err := client.Health(ctx); err != nil {
+		t.Fatalf("Health() error = %v", err)
+	}
+
+	srv.SetServing(false)
+	if err := client.Health(ctx); !errors.Is(err, go_sdk.ErrNotServing) {
+		t.Fatalf("expected ErrNotServing, got %v", err)
+	}
+}
+
+func TestWaitForReady(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.SetServing(false)
+	client := srv.Client(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.WaitForReady(ctx); err == nil {
+		t.Fatal("expected WaitForReady to time out while not serving")
+	}
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		srv.SetServing(true)
+	}()
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.WaitForReady(ctx); err != nil {
+		t.Fatalf("WaitForReady() error = %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package go_sdk
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/internal/telemetry"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxHedgeTokens bounds how many hedges can be sent in a burst after a quiet period
+const maxHedgeTokens = 10
+
+// HedgingPolicy sends a duplicate of a slow AnalyzeSentiment call over a second
+// connection and uses whichever response arrives first, cancelling the other.
+type HedgingPolicy struct {
+	// Delay is how long to wait for a response before sending a hedge. Defaults to 100ms.
+	Delay time.Duration
+	// MaxHedges is the number of duplicates sent per call, each after another Delay. Defaults to 1.
+	MaxHedges int
+	// MaxRatio caps hedges as a fraction of calls, e.g. 0.1 for at most one hedge
+	// per ten calls over time. Defaults to 0.1.
+	MaxRatio float64
+	// Address is the backend hedges are sent to. Defaults to a second
+	// connection to Config.Address, which a load balancer may route elsewhere.
+	Address string
+}
+
+// withDefaults fills zero fields of the policy
+func (p HedgingPolicy) withDefaults() HedgingPolicy {
+	if p.Delay <= 0 {
+		p.Delay = 100 * time.Millisecond
+	}
+	if p.MaxHedges <= 0 {
+		p.MaxHedges = 1
+	}
+	if p.MaxRatio <= 0 {
+		p.MaxRatio = 0.1
+	}
+	return p
+}
+
+// HedgeStats counts the work done by hedging
+type HedgeStats struct {
+	// Calls is the number of calls eligible for hedging
+	Calls int64
+	// Hedges is the number of duplicate requests sent
+	Hedges int64
+	// Wins is the number of calls answered by a hedge before the original request
+	Wins int64
+	// Throttled is the number of hedges skipped because of MaxRatio
+	Throttled int64
+}
+
+// hedger races duplicate requests on a second connection
+type hedger struct {
+	policy      HedgingPolicy
+	conn        *grpc.ClientConn
+	instruments *telemetry.Instruments
+
+	mu     sync.Mutex
+	tokens float64
+
+	calls, hedges, wins, throttled atomic.Int64
+}
+
+func (h *hedger) stats() HedgeStats {
+	return HedgeStats{
+		Calls:     h.calls.Load(),
+		Hedges:    h.hedges.Load(),
+		Wins:      h.wins.Load(),
+		Throttled: h.throttled.Load(),
+	}
+}
+
+// earn adds the hedging budget of one call
+func (h *hedger) earn() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tokens = min(h.tokens+h.policy.MaxRatio, maxHedgeTokens)
+}
+
+// spend takes the budget of one hedge, if available
+func (h *hedger) spend() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+	return true
+}
+
+type hedgeResult struct {
+	reply *pb.SentimentResponse
+	err   error
+	hedge int
+}
+
+// unaryInterceptor hedges AnalyzeSentiment calls. Each request goes through the
+// retry and attempt interceptors of its own connection.
+func (h *hedger) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		out, ok := reply.(*pb.SentimentResponse)
+		if method != pb.NLPManager_AnalyzeSentiment_FullMethodName || !ok {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		h.calls.Add(1)
+		h.earn()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		// Every request writes its own response, so losers can finish in the background
+		results := make(chan hedgeResult, h.policy.MaxHedges+1)
+		send := func(n int, invoke func(*pb.SentimentResponse) error) {
+			r := new(pb.SentimentResponse)
+			results <- hedgeResult{reply: r, err: invoke(r), hedge: n}
+		}
+		go send(0, func(r *pb.SentimentResponse) error {
+			return invoker(ctx, method, req, r, cc, opts...)
+		})
+
+		timer := time.NewTimer(h.policy.Delay)
+		defer timer.Stop()
+
+		sent, pending := 0, 1
+		var firstErr error
+		for {
+			select {
+			case <-timer.C:
+				if !h.spend() {
+					h.throttled.Add(1)
+					continue
+				}
+				sent++
+				pending++
+				h.hedges.Add(1)
+				go send(sent, func(r *pb.SentimentResponse) error {
+					return h.conn.Invoke(ctx, method, req, r, opts...)
+				})
+				if sent < h.policy.MaxHedges {
+					timer.Reset(h.policy.Delay)
+				}
+
+			case res := <-results:
+				pending--
+				if res.err == nil {
+					h.record(ctx, sent, res.hedge)
+					proto.Merge(out, res.reply)
+					return nil
+				}
+				if firstErr == nil {
+					firstErr = res.err
+				}
+				if pending == 0 {
+					h.record(ctx, sent, -1)
+					return firstErr
+				}
+			}
+		}
+	}
+}
+
+// record reports the outcome of every hedge sent for a call; winner is the
+// hedge that answered, 0 for the original request or -1 when all failed
+func (h *hedger) record(ctx context.Context, sent, winner int) {
+	if winner > 0 {
+		h.wins.Add(1)
+	}
+	for n := 1; n <= sent; n++ {
+		h.instruments.Hedge(ctx, n == winner)
+	}
+}
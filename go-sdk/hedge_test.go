@@ -0,0 +1,74 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestHedgeWinsOverStalledRequest(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("عالی بود").After(2 * time.Second).Times(1)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Hedging: &go_sdk.HedgingPolicy{Delay: 20 * time.Millisecond, MaxRatio: 1},
+	})
+
+	start := time.Now()
+	result, err := client.Analyze(context.Background(), "عالی بود")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("hedged call took %s", d)
+	}
+	if !result.IsPositive() {
+		t.Errorf("expected positive result, got %s", result.Sentiment)
+	}
+	if n := srv.Count("عالی بود"); n != 2 {
+		t.Errorf("expected the original request and one hedge, got %d", n)
+	}
+
+	stats := client.HedgeStats()
+	if stats.Calls != 1 || stats.Hedges != 1 || stats.Wins != 1 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestHedgeRatioLimit(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.OnAny().After(60 * time.Millisecond)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Hedging: &go_sdk.HedgingPolicy{Delay: 10 * time.Millisecond, MaxRatio: 0.5},
+	})
+
+	for i := 0; i < 4; i++ {
+		if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+	}
+
+	stats := client.HedgeStats()
+	if stats.Calls != 4 || stats.Hedges != 2 || stats.Throttled != 2 {
+		t.Errorf("expected every other call to be hedged, got %+v", stats)
+	}
+}
+
+func TestFastCallsAreNotHedged(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Hedging: &go_sdk.HedgingPolicy{Delay: time.Second, MaxRatio: 1},
+	})
+
+	if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if n := srv.Count("خوب"); n != 1 {
+		t.Errorf("expected a single request, got %d", n)
+	}
+}
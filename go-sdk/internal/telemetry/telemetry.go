@@ -0,0 +1,351 @@
+// Package telemetry records OpenTelemetry spans and metrics for NLPManager
+// calls. It is shared by the client and the Go server so both sides report the
+// same attributes.
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	metricnoop "go.opentelemetry.io/otel/metric/noop"
+	"go.opentelemetry.io/otel/trace"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ScopeName is the instrumentation scope of every tracer and meter
+const ScopeName = "github.com/Mannymz/ZenNLP/go-sdk"
+
+// Attribute keys set on spans and metrics
+const (
+	MethodKey     = attribute.Key("rpc.method")
+	ServiceKey    = attribute.Key("rpc.service")
+	StatusKey     = attribute.Key("rpc.grpc.status_code")
+	CodeKey       = attribute.Key("rpc.grpc.status")
+	TextLengthKey = attribute.Key("zennlp.text.length")
+	LangKey       = attribute.Key("zennlp.lang")
+	LabelKey      = attribute.Key("zennlp.label")
+	BatchSizeKey  = attribute.Key("zennlp.batch.size")
+	AttemptKey    = attribute.Key("zennlp.attempt")
+	AttemptsKey   = attribute.Key("zennlp.attempts")
+	HedgeWonKey   = attribute.Key("zennlp.hedge.won")
+)
+
+// durationBuckets are the latency histogram boundaries in seconds
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+// Instruments creates spans and records metrics for one side of a connection
+type Instruments struct {
+	tracer   trace.Tracer
+	kind     trace.SpanKind
+	duration metric.Float64Histogram
+	requests metric.Int64Counter
+	labels   metric.Int64Counter
+	hedges   metric.Int64Counter
+}
+
+// New creates instruments named after side, "client" or "server". Nil
+// providers record nothing.
+func New(side string, tp trace.TracerProvider, mp metric.MeterProvider) (*Instruments, error) {
+	if tp == nil {
+		tp = tracenoop.NewTracerProvider()
+	}
+	if mp == nil {
+		mp = metricnoop.NewMeterProvider()
+	}
+	meter := mp.Meter(ScopeName)
+
+	in := &Instruments{
+		tracer: tp.Tracer(ScopeName),
+		kind:   trace.SpanKindClient,
+	}
+	if side == "server" {
+		in.kind = trace.SpanKindServer
+	}
+
+	var err error
+	in.duration, err = meter.Float64Histogram("zennlp."+side+".duration",
+		metric.WithDescription("Duration of NLPManager calls"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(durationBuckets...))
+	if err != nil {
+		return nil, err
+	}
+	in.requests, err = meter.Int64Counter("zennlp."+side+".requests",
+		metric.WithDescription("NLPManager calls by method and status code"),
+		metric.WithUnit("{call}"))
+	if err != nil {
+		return nil, err
+	}
+	in.labels, err = meter.Int64Counter("zennlp."+side+".labels",
+		metric.WithDescription("Analyzed texts by method and sentiment label"),
+		metric.WithUnit("{text}"))
+	if err != nil {
+		return nil, err
+	}
+	in.hedges, err = meter.Int64Counter("zennlp."+side+".hedges",
+		metric.WithDescription("Hedged requests by whether they answered first"),
+		metric.WithUnit("{request}"))
+	if err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// Hedge records a hedged request of the call in ctx and whether it won the race
+func (in *Instruments) Hedge(ctx context.Context, won bool) {
+	in.hedges.Add(ctx, 1, metric.WithAttributes(HedgeWonKey.Bool(won)))
+	if call, ok := ctx.Value(callKey{}).(*Call); ok {
+		call.span.AddEvent("hedge", trace.WithAttributes(HedgeWonKey.Bool(won)))
+	}
+}
+
+// Call is an instrumented call in progress
+type Call struct {
+	in       *Instruments
+	ctx      context.Context
+	span     trace.Span
+	method   string
+	start    time.Time
+	attempts atomic.Int32
+	once     sync.Once
+}
+
+type callKey struct{}
+
+// Start starts a span for method with the attributes of req
+func (in *Instruments) Start(ctx context.Context, method string, req any) (context.Context, *Call) {
+	service, name := SplitMethod(method)
+	ctx, span := in.tracer.Start(ctx, strings.TrimPrefix(method, "/"),
+		trace.WithSpanKind(in.kind),
+		trace.WithAttributes(ServiceKey.String(service), MethodKey.String(name)),
+		trace.WithAttributes(requestAttributes(req)...))
+
+	call := &Call{in: in, span: span, method: name, start: time.Now()}
+	ctx = context.WithValue(ctx, callKey{}, call)
+	call.ctx = ctx
+	return ctx, call
+}
+
+// Attempt records the start of another attempt of the call in ctx, if any
+func Attempt(ctx context.Context) {
+	call, ok := ctx.Value(callKey{}).(*Call)
+	if !ok {
+		return
+	}
+	n := call.attempts.Add(1)
+	call.span.AddEvent("attempt", trace.WithAttributes(AttemptKey.Int(int(n))))
+}
+
+// Message records the labels of a response received or sent on a stream
+func (c *Call) Message(resp any) {
+	c.countLabels(resp)
+}
+
+// End finishes the call with its response and error. Only the first call has
+// an effect, so streams may end from several places.
+func (c *Call) End(resp any, err error) {
+	c.once.Do(func() {
+		code := status.Code(err)
+		if errors.Is(err, io.EOF) {
+			code, err = codes.OK, nil
+		}
+
+		if n := c.attempts.Load(); n > 0 {
+			c.span.SetAttributes(AttemptsKey.Int(int(n)))
+		}
+		c.span.SetAttributes(StatusKey.Int(int(code)))
+		if labels := ResponseLabels(resp); err == nil && len(labels) == 1 {
+			c.span.SetAttributes(LabelKey.String(labels[0]))
+		}
+		if err != nil {
+			c.span.RecordError(err)
+			c.span.SetStatus(otelcodes.Error, status.Convert(err).Message())
+		}
+		c.span.End()
+
+		attrs := metric.WithAttributes(MethodKey.String(c.method), CodeKey.String(code.String()))
+		c.in.duration.Record(c.ctx, time.Since(c.start).Seconds(), attrs)
+		c.in.requests.Add(c.ctx, 1, attrs)
+		if err == nil {
+			c.countLabels(resp)
+		}
+	})
+}
+
+func (c *Call) countLabels(resp any) {
+	for _, label := range ResponseLabels(resp) {
+		c.in.labels.Add(c.ctx, 1, metric.WithAttributes(MethodKey.String(c.method), LabelKey.String(label)))
+	}
+}
+
+// UnaryClientInterceptor instruments unary calls. Attempts are counted by
+// AttemptInterceptor, which has to run inside any retry interceptor.
+func (in *Instruments) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, call := in.Start(ctx, method, req)
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		call.End(reply, err)
+		return err
+	}
+}
+
+// AttemptInterceptor records every attempt of an instrumented unary call
+func AttemptInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		Attempt(ctx)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamClientInterceptor instruments streaming calls until the stream ends
+func (in *Instruments) StreamClientInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, call := in.Start(ctx, method, nil)
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			call.End(nil, err)
+			return nil, err
+		}
+		return &clientStream{ClientStream: stream, call: call}, nil
+	}
+}
+
+// UnaryServerInterceptor instruments unary calls handled by a server
+func (in *Instruments) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, call := in.Start(ctx, info.FullMethod, req)
+		resp, err := handler(ctx, req)
+		call.End(resp, err)
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor instruments streaming calls handled by a server
+func (in *Instruments) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, call := in.Start(ss.Context(), info.FullMethod, nil)
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx, call: call})
+		call.End(nil, err)
+		return err
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	call *Call
+}
+
+func (s *clientStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		s.call.End(nil, err)
+		return err
+	}
+	s.call.Message(m)
+	return nil
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	call *Call
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *serverStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.call.Message(m)
+	}
+	return err
+}
+
+// requestAttributes describes the texts of a request
+func requestAttributes(req any) []attribute.KeyValue {
+	switch req := req.(type) {
+	case *pb.SentimentRequest:
+		return []attribute.KeyValue{
+			TextLengthKey.Int(utf8.RuneCountInString(req.Text)),
+			LangKey.String(req.Lang),
+		}
+	case *pb.SentimentBatchRequest:
+		length := 0
+		for _, item := range req.Items {
+			length += utf8.RuneCountInString(item.Text)
+		}
+		attrs := []attribute.KeyValue{
+			BatchSizeKey.Int(len(req.Items)),
+			TextLengthKey.Int(length),
+		}
+		if len(req.Items) > 0 {
+			attrs = append(attrs, LangKey.String(req.Items[0].Lang))
+		}
+		return attrs
+	}
+	return nil
+}
+
+// TextLengths returns the length in runes of every text in req
+func TextLengths(req any) []int {
+	switch req := req.(type) {
+	case *pb.SentimentRequest:
+		return []int{utf8.RuneCountInString(req.Text)}
+	case *pb.SentimentBatchRequest:
+		lengths := make([]int, len(req.Items))
+		for i, item := range req.Items {
+			lengths[i] = utf8.RuneCountInString(item.Text)
+		}
+		return lengths
+	case *pb.SentimentStreamRequest:
+		return []int{utf8.RuneCountInString(req.GetRequest().GetText())}
+	}
+	return nil
+}
+
+// ResponseLabels returns the label of every successful item in resp
+func ResponseLabels(resp any) []string {
+	switch resp := resp.(type) {
+	case *pb.SentimentResponse:
+		if resp != nil {
+			return []string{resp.Label}
+		}
+	case *pb.SentimentBatchResponse:
+		var labels []string
+		for _, result := range resp.GetResults() {
+			if r := result.GetResponse(); r != nil {
+				labels = append(labels, r.Label)
+			}
+		}
+		return labels
+	case *pb.SentimentStreamResponse:
+		if r := resp.GetResponse(); r != nil {
+			return []string{r.Label}
+		}
+	}
+	return nil
+}
+
+// SplitMethod splits a full gRPC method name into its service and method
+func SplitMethod(fullMethod string) (service, method string) {
+	fullMethod = strings.TrimPrefix(fullMethod, "/")
+	if i := strings.LastIndex(fullMethod, "/"); i >= 0 {
+		return fullMethod[:i], fullMethod[i+1:]
+	}
+	return "", fullMethod
+}
@@ -0,0 +1,45 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// Lemma is a word of a text with its stem and dictionary form
+type Lemma struct {
+	Word string
+	// Stem is the word without its inflectional affixes; for verbs, the past
+	// or present stem, e.g. خور for می‌خورم
+	Stem string
+	// Lemma is the singular of nouns, the positive of adjectives and the
+	// infinitive of verbs, e.g. خوردن for می‌خورم
+	Lemma string
+	// Start and End are the offsets of the word in the text in runes, End exclusive
+	Start, End int
+}
+
+// Lemmatize returns the stem and lemma of every word of text, in order, e.g. to
+// group reviews by root word. Numbers and punctuation are left out.
+func (c *Client) Lemmatize(ctx context.Context, text string) ([]Lemma, error) {
+	resp, err := c.client.Lemmatize(ctx, &pb.LemmatizeRequest{
+		Text: text,
+		Lang: "fa",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("lemmatization failed: %w", err)
+	}
+
+	lemmas := make([]Lemma, len(resp.Lemmas))
+	for i, l := range resp.Lemmas {
+		lemmas[i] = Lemma{
+			Word:  l.Word,
+			Stem:  l.Stem,
+			Lemma: l.Lemma,
+			Start: int(l.Start),
+			End:   int(l.End),
+		}
+	}
+	return lemmas, nil
+}
@@ -0,0 +1,42 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestLemmatize(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.Client(t)
+
+	text := "پیتزاها را نمی‌خوردیم"
+	lemmas, err := client.Lemmatize(context.Background(), text)
+	if err != nil {
+		t.Fatalf("Lemmatize() error = %v", err)
+	}
+
+	want := []string{"پیتزا", "را", "خوردن"}
+	if len(lemmas) != len(want) {
+		t.Fatalf("Lemmatize() returned %d lemmas, want %d", len(lemmas), len(want))
+	}
+	runes := []rune(text)
+	for i, l := range lemmas {
+		if l.Lemma != want[i] {
+			t.Errorf("lemma %d = %q, want %q", i, l.Lemma, want[i])
+		}
+		if string(runes[l.Start:l.End]) != l.Word {
+			t.Errorf("offsets of %q select %q", l.Word, string(runes[l.Start:l.End]))
+		}
+	}
+	if lemmas[2].Stem != "خورد" {
+		t.Errorf("stem = %q, want %q", lemmas[2].Stem, "خورد")
+	}
+
+	requests := srv.Requests()
+	if len(requests) != 1 || requests[0].Method != pb.NLPManager_Lemmatize_FullMethodName {
+		t.Errorf("unexpected requests %v", requests)
+	}
+}
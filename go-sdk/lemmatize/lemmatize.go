@@ -0,0 +1,330 @@
+// Package lemmatize reduces Persian words to their stems and lemmas with rules
+// and a small lexicon, so that inflected forms can be grouped by root word:
+//
+//	lemmatize.Lemma("کتاب‌هایشان") // "کتاب"
+//	lemmatize.Lemma("نمی‌خوردم")   // "خوردن"
+//	lemmatize.Stem("نمی‌خوردم")    // "خورد"
+//
+// Plural suffixes (ها, ان, ات), comparatives and superlatives (تر, ترین),
+// possessive clitics and the verb prefixes می, نمی, ب and ن are removed, and
+// verbs are mapped to their infinitive through a table of past and present
+// stems that covers irregular verbs. Suffixes that also end ordinary words,
+// such as ان in ارزان, are only removed when what remains is a known word.
+//
+// Text is best normalized first, see the normalize package, so that prefixes
+// and suffixes are joined to their word with a half-space.
+package lemmatize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/normalize"
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+)
+
+const zwnj = "\u200c"
+
+// Word is a word of a text with its stem and lemma
+type Word struct {
+	tokenize.Token
+	// Stem is the word without its inflectional affixes. For verbs it is the
+	// past or present stem the form is built on, e.g. خور for می‌خورم.
+	Stem string
+	// Lemma is the dictionary form: the singular of nouns, the positive of
+	// adjectives and the infinitive of verbs
+	Lemma string
+}
+
+// Lemmatizer analyzes words with the built-in lexicon and verb table extended
+// by extra known words. It is safe for concurrent use.
+type Lemmatizer struct {
+	words map[string]bool
+}
+
+// New creates a lemmatizer that also knows the given words, e.g. the product
+// names of a domain. Known words are never reduced further.
+func New(words ...string) *Lemmatizer {
+	l := &Lemmatizer{words: make(map[string]bool, len(knownWords)+len(words))}
+	for w := range knownWords {
+		l.words[w] = true
+	}
+	for _, w := range words {
+		l.words[clean(w)] = true
+	}
+	return l
+}
+
+var defaultLemmatizer = New()
+
+// Stem returns the stem of word using the built-in lexicon
+func Stem(word string) string {
+	return defaultLemmatizer.Stem(word)
+}
+
+// Lemma returns the lemma of word using the built-in lexicon
+func Lemma(word string) string {
+	return defaultLemmatizer.Lemma(word)
+}
+
+// IsVerb reports whether word is a conjugated verb, an infinitive or a form
+// of the copula بودن
+func IsVerb(word string) bool {
+	return defaultLemmatizer.IsVerb(word)
+}
+
+// Lemmatize returns the words of text with their stems and lemmas, using the
+// built-in lexicon
+func Lemmatize(text string) []Word {
+	return defaultLemmatizer.Lemmatize(text)
+}
+
+// Stem returns the word without its inflectional affixes
+func (l *Lemmatizer) Stem(word string) string {
+	stem, _ := l.analyze(word)
+	return stem
+}
+
+// Lemma returns the dictionary form of word
+func (l *Lemmatizer) Lemma(word string) string {
+	_, lemma := l.analyze(word)
+	return lemma
+}
+
+// IsVerb reports whether word is a conjugated verb, an infinitive or a form
+// of the copula بودن. Known words are never verbs.
+func (l *Lemmatizer) IsVerb(word string) bool {
+	w := clean(word)
+	if l.words[w] {
+		return false
+	}
+	_, _, ok := verb(w)
+	return ok
+}
+
+// Lemmatize tokenizes text and analyzes every word. Numbers, punctuation and
+// other tokens are left out.
+func (l *Lemmatizer) Lemmatize(text string) []Word {
+	var words []Word
+	for _, tok := range tokenize.Tokenize(text) {
+		if tok.Kind != tokenize.Word {
+			continue
+		}
+		stem, lemma := l.analyze(tok.Text)
+		words = append(words, Word{Token: tok, Stem: stem, Lemma: lemma})
+	}
+	return words
+}
+
+// cleaner unifies letters and drops marks without touching half-spaces or digits
+var cleaner = normalize.New(normalize.Options{
+	UnifyCharacters:  true,
+	RemoveDiacritics: true,
+	RemoveTatweel:    true,
+})
+
+func clean(word string) string {
+	return strings.Trim(cleaner.Normalize(strings.TrimSpace(word)), zwnj)
+}
+
+func (l *Lemmatizer) analyze(word string) (stem, lemma string) {
+	w := clean(word)
+	if r, _ := utf8.DecodeRuneInString(w); !unicode.Is(unicode.Arabic, r) {
+		w = strings.ToLower(w)
+		return w, w
+	}
+	if l.words[w] {
+		return w, w
+	}
+	if stem, lemma, ok := verb(w); ok {
+		return stem, lemma
+	}
+	w = l.noun(w)
+	return w, w
+}
+
+// Prefixes of verbs, longest first
+var (
+	aspectPrefixes = []string{"نمی" + zwnj, "می" + zwnj, "نمی", "می"}
+	moodPrefixes   = []string{"ب", "ن"}
+)
+
+var (
+	pastEndings = map[string]bool{
+		"": true, "م": true, "ی": true, "یم": true, "ید": true, "ند": true,
+		// Infinitive
+		"ن": true,
+		// Past participle and present perfect
+		"ه": true, "ه" + zwnj + "ام": true, "ه" + zwnj + "ای": true, "ه" + zwnj + "است": true,
+		"ه" + zwnj + "ایم": true, "ه" + zwnj + "اید": true, "ه" + zwnj + "اند": true,
+	}
+	presentEndings = map[string]bool{
+		"م": true, "ی": true, "د": true, "یم": true, "ید": true, "ند": true,
+	}
+)
+
+// verb recognizes conjugated verbs and returns their stem and infinitive
+func verb(w string) (stem, lemma string, ok bool) {
+	if stem, ok := copula(w); ok {
+		return stem, "بودن", true
+	}
+
+	body, prefixed := w, false
+	for _, p := range aspectPrefixes {
+		if rest, found := strings.CutPrefix(w, p); found && rest != "" {
+			body, prefixed = rest, true
+			break
+		}
+	}
+	if stem, lemma, ok := conjugation(body, prefixed, false); ok {
+		return stem, lemma, true
+	}
+	if prefixed {
+		return "", "", false
+	}
+
+	for _, p := range moodPrefixes {
+		rest, found := strings.CutPrefix(w, p)
+		if !found {
+			continue
+		}
+		// آ becomes یا after a prefix, as in بیا and نیامد
+		if after, ok := strings.CutPrefix(rest, "یا"); ok {
+			rest = "آ" + after
+		}
+		// ب marks the subjunctive and imperative, built on the present stem,
+		// so بخرید is خر + ید rather than the past خرید
+		if p == "ب" {
+			if stem, lemma, ok := presentConjugation(rest, true, true); ok {
+				return stem, lemma, true
+			}
+		}
+		if stem, lemma, ok := conjugation(rest, true, true); ok {
+			return stem, lemma, true
+		}
+	}
+	return "", "", false
+}
+
+// conjugation matches body against the stems of the verb table, past stems first
+func conjugation(body string, prefixed, mood bool) (stem, lemma string, ok bool) {
+	for _, past := range pastStems {
+		if ending, found := strings.CutPrefix(body, past); found && pastEndings[ending] {
+			return past, past + "ن", true
+		}
+	}
+	return presentConjugation(body, prefixed, mood)
+}
+
+// presentConjugation matches body against the present stems. Present forms
+// need a prefix unless the verb is commonly used without one, and imperatives,
+// the bare present stem, need the prefix ب or ن.
+func presentConjugation(body string, prefixed, mood bool) (stem, lemma string, ok bool) {
+	for _, present := range presentStems {
+		ending, found := strings.CutPrefix(body, present)
+		if !found {
+			continue
+		}
+		if presentEndings[ending] && (prefixed || bareStems[present]) || ending == "" && mood {
+			return present, verbs[present] + "ن", true
+		}
+	}
+	return "", "", false
+}
+
+// copula recognizes the present forms of بودن, as in است, هستم and نیستند
+func copula(w string) (string, bool) {
+	if w == "است" {
+		return w, true
+	}
+	for _, base := range []string{"هست", "نیست"} {
+		if ending, found := strings.CutPrefix(w, base); found && (ending == "" || presentEndings[ending]) {
+			return "هست", true
+		}
+	}
+	return "", false
+}
+
+// suffix is an inflectional suffix of nouns and adjectives
+type suffix struct {
+	text string
+	// restore is appended to the word once the suffix is removed, e.g. the ه
+	// of راننده that is dropped in رانندگان
+	restore string
+	// after, when set, lists the letters the word must end with
+	after string
+	// safe suffixes are removed even when the rest is not a known word
+	safe bool
+}
+
+// attachedSuffixes are written without a half-space, longest first
+var attachedSuffixes = []suffix{
+	{text: "هایشان", safe: true}, {text: "هایتان", safe: true}, {text: "هایمان", safe: true},
+	{text: "هایش", safe: true}, {text: "هایت", safe: true}, {text: "هایم", safe: true},
+	{text: "هایی", safe: true}, {text: "های", safe: true}, {text: "ها", safe: true},
+	{text: "ترین", safe: true}, {text: "تری"}, {text: "تر"},
+	{text: "یشان", after: "او"}, {text: "یتان", after: "او"}, {text: "یمان", after: "او"},
+	{text: "شان"}, {text: "تان"}, {text: "مان"},
+	{text: "یش", after: "او"}, {text: "یت", after: "او"}, {text: "یم", after: "او"},
+	{text: "گان", restore: "ه"}, {text: "یان", after: "او"}, {text: "جات"}, {text: "ان"}, {text: "ات"},
+	{text: "ش"}, {text: "ت"}, {text: "م"}, {text: "ی"},
+}
+
+// detachedSuffixes follow their word after a half-space
+var detachedSuffixes = map[string]bool{
+	"ها": true, "های": true, "هایی": true, "هایم": true, "هایت": true, "هایش": true,
+	"هایمان": true, "هایتان": true, "هایشان": true,
+	"تر": true, "تری": true, "ترین": true, "جات": true,
+	"ام": true, "ات": true, "اش": true, "مان": true, "تان": true, "شان": true,
+	"ای": true, "ی": true, "ایم": true, "اید": true, "اند": true,
+}
+
+// maxSuffixes bounds how many attached suffixes are removed from a word
+const maxSuffixes = 3
+
+// noun removes the suffixes of nouns and adjectives
+func (l *Lemmatizer) noun(w string) string {
+	parts := strings.Split(w, zwnj)
+	for len(parts) > 1 && detachedSuffixes[parts[len(parts)-1]] {
+		parts = parts[:len(parts)-1]
+	}
+	w = strings.Join(parts, zwnj)
+
+	base, _ := l.base(w, 0)
+	return base
+}
+
+// base removes attached suffixes from w. It reports whether the result is a
+// known word or was reached by removing a safe suffix.
+func (l *Lemmatizer) base(w string, depth int) (string, bool) {
+	if l.words[w] {
+		return w, true
+	}
+	if lemma, ok := irregular[w]; ok {
+		return lemma, true
+	}
+	if depth == maxSuffixes {
+		return w, false
+	}
+
+	for _, s := range attachedSuffixes {
+		rest, found := strings.CutSuffix(w, s.text)
+		if !found || utf8.RuneCountInString(rest) < 2 {
+			continue
+		}
+		if s.after != "" && !strings.ContainsRune(s.after, lastRune(rest)) {
+			continue
+		}
+		rest += s.restore
+		if b, ok := l.base(rest, depth+1); ok || s.safe {
+			return b, true
+		}
+	}
+	return w, false
+}
+
+func lastRune(s string) rune {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return r
+}
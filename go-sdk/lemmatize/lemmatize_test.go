@@ -0,0 +1,94 @@
+package lemmatize_test
+
+import (
+	"testing"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/lemmatize"
+)
+
+func TestLemma(t *testing.T) {
+	tests := []struct {
+		name  string
+		word  string
+		stem  string
+		lemma string
+	}{
+		{"plural with half-space", "کتاب‌ها", "کتاب", "کتاب"},
+		{"attached plural and possessive", "کتابهایشان", "کتاب", "کتاب"},
+		{"plural ان", "درختان", "درخت", "درخت"},
+		{"plural گان", "رانندگان", "راننده", "راننده"},
+		{"plural یان", "دانشجویان", "دانشجو", "دانشجو"},
+		{"word ending in ان", "ارزان", "ارزان", "ارزان"},
+		{"comparative", "بزرگتر", "بزرگ", "بزرگ"},
+		{"superlative with half-space", "خوشمزه‌ترین", "خوشمزه", "خوشمزه"},
+		{"irregular comparative", "بهترین", "خوب", "خوب"},
+		{"word ending in تر", "دختر", "دختر", "دختر"},
+		{"possessive after vowel", "غذایش", "غذا", "غذا"},
+		{"possessive with half-space", "خانه‌ام", "خانه", "خانه"},
+		{"plural and possessive", "دوستانم", "دوست", "دوست"},
+		{"continuous present", "می‌خورم", "خور", "خوردن"},
+		{"negated continuous", "نمی‌خواهند", "خواه", "خواستن"},
+		{"prefix without half-space", "میروم", "رو", "رفتن"},
+		{"simple past", "خریدیم", "خرید", "خریدن"},
+		{"negated past", "نیامد", "آمد", "آمدن"},
+		{"subjunctive", "بیاید", "آ", "آمدن"},
+		{"imperative", "ببینید", "بین", "دیدن"},
+		{"subjunctive matching a past form", "بخرید", "خر", "خریدن"},
+		{"present perfect", "رفته‌اند", "رفت", "رفتن"},
+		{"light verb", "کنید", "کن", "کردن"},
+		{"copula", "نیستند", "هست", "بودن"},
+		{"infinitive", "گفتن", "گفت", "گفتن"},
+		{"arabic letters and marks", "كتابهـا", "کتاب", "کتاب"},
+		{"latin", "iPhone", "iphone", "iphone"},
+		{"unknown word", "زرشک", "زرشک", "زرشک"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := lemmatize.Stem(tt.word); got != tt.stem {
+				t.Errorf("Stem(%q) = %q, want %q", tt.word, got, tt.stem)
+			}
+			if got := lemmatize.Lemma(tt.word); got != tt.lemma {
+				t.Errorf("Lemma(%q) = %q, want %q", tt.word, got, tt.lemma)
+			}
+		})
+	}
+}
+
+func TestKnownWords(t *testing.T) {
+	if got := lemmatize.Lemma("کوفتگان"); got != "کوفتگان" {
+		t.Fatalf("Lemma() = %q, expected an unknown word to be kept", got)
+	}
+	l := lemmatize.New("کوفته")
+	if got := l.Lemma("کوفتگان"); got != "کوفته" {
+		t.Errorf("Lemma() = %q, want %q", got, "کوفته")
+	}
+}
+
+func TestLemmatize(t *testing.T) {
+	text := "غذاها سرد بودند، 2 بار سفارش دادیم!"
+	want := []string{"غذا", "سرد", "بودن", "بار", "سفارش", "دادن"}
+
+	words := lemmatize.Lemmatize(text)
+	if len(words) != len(want) {
+		t.Fatalf("Lemmatize() returned %d words, want %d", len(words), len(want))
+	}
+	for i, w := range words {
+		if w.Lemma != want[i] {
+			t.Errorf("word %d: Lemma = %q, want %q", i, w.Lemma, want[i])
+		}
+		if text[w.Start:w.End] != w.Text {
+			t.Errorf("word %d: offsets select %q, want %q", i, text[w.Start:w.End], w.Text)
+		}
+	}
+}
+
+func TestIsVerb(t *testing.T) {
+	for word, want := range map[string]bool{
+		"می‌خورم": true, "نرفتند": true, "است": true, "گفتن": true,
+		"کتاب‌ها": false, "مرد": false, "خوب": false,
+	} {
+		if got := lemmatize.IsVerb(word); got != want {
+			t.Errorf("IsVerb(%q) = %v, want %v", word, got, want)
+		}
+	}
+}
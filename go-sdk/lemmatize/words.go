@@ -0,0 +1,103 @@
+package lemmatize
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// verbTable maps the past stem of common verbs to their present stem. The
+// infinitive is the past stem followed by ن.
+var verbTable = map[string]string{
+	"آمد": "آ", "آورد": "آور", "آموخت": "آموز", "افتاد": "افت", "انداخت": "انداز",
+	"ایستاد": "ایست", "برد": "بر", "بست": "بند", "بود": "باش", "پخت": "پز",
+	"پذیرفت": "پذیر", "پرداخت": "پرداز", "پرسید": "پرس", "پسندید": "پسند", "پوشید": "پوش",
+	"ترسید": "ترس", "توانست": "توان", "جست": "جو", "چشید": "چش", "خرید": "خر",
+	"خواست": "خواه", "خواند": "خوان", "خوابید": "خواب", "خورد": "خور", "داد": "ده",
+	"داشت": "دار", "دانست": "دان", "دوید": "دو", "دید": "بین", "رسید": "رس",
+	"رفت": "رو", "ریخت": "ریز", "زد": "زن", "ساخت": "ساز", "سپرد": "سپار",
+	"سوخت": "سوز", "شد": "شو", "شست": "شوی", "شکست": "شکن", "شناخت": "شناس",
+	"شنید": "شنو", "فرستاد": "فرست", "فروخت": "فروش", "فهمید": "فهم", "کرد": "کن",
+	"کشید": "کش", "گذاشت": "گذار", "گذشت": "گذر", "گرفت": "گیر", "گشت": "گرد",
+	"گفت": "گو", "ماند": "مان", "نشست": "نشین", "نوشت": "نویس", "یافت": "یاب",
+}
+
+// bareStems are verbs whose present forms are common without a prefix, as in
+// دارم, باشد or the light verb of استفاده کنید
+var bareStems = map[string]bool{
+	"دار": true, "باش": true, "کن": true, "شو": true, "خواه": true, "توان": true,
+}
+
+var (
+	// verbs maps present stems to past stems
+	verbs = make(map[string]string, len(verbTable))
+	// pastStems and presentStems are sorted longest first, so that the longest
+	// stem a form starts with is matched
+	pastStems, presentStems []string
+)
+
+func init() {
+	for past, present := range verbTable {
+		verbs[present] = past
+		pastStems = append(pastStems, past)
+		presentStems = append(presentStems, present)
+	}
+	longestFirst(pastStems)
+	longestFirst(presentStems)
+}
+
+func longestFirst(stems []string) {
+	sort.Slice(stems, func(i, j int) bool {
+		ni, nj := utf8.RuneCountInString(stems[i]), utf8.RuneCountInString(stems[j])
+		if ni != nj {
+			return ni > nj
+		}
+		return stems[i] < stems[j]
+	})
+}
+
+// irregular maps comparatives and superlatives that are not built on their positive
+var irregular = map[string]string{
+	"بهتر": "خوب", "بهترین": "خوب",
+}
+
+// knownWords are base forms that are not reduced further. Words ending in
+// letters that look like suffixes, such as ارزان or دختر, must be listed here
+// or they may be shortened by mistake.
+var knownWords = map[string]bool{
+	// Food and ordering
+	"غذا": true, "پیتزا": true, "برگر": true, "همبرگر": true, "ساندویچ": true, "کباب": true,
+	"برنج": true, "مرغ": true, "گوشت": true, "ماهی": true, "سالاد": true, "سوپ": true,
+	"نوشابه": true, "دوغ": true, "سس": true, "نان": true, "پنیر": true, "سیب‌زمینی": true,
+	"کیک": true, "شیرینی": true, "قهوه": true, "چای": true, "میوه": true, "سبزی": true,
+	"سبزیجات": true, "دسر": true, "بستنی": true, "رستوران": true, "کافه": true, "آشپز": true,
+	"منو": true, "سفارش": true, "ارسال": true, "پیک": true, "بسته": true, "بسته‌بندی": true,
+	"ظرف": true, "قیمت": true, "کیفیت": true, "طعم": true, "مزه": true, "حجم": true,
+	"اندازه": true, "تخفیف": true, "هزینه": true, "پول": true, "تومان": true, "ریال": true,
+	"کالا": true, "محصول": true, "جنس": true, "گوشی": true, "کفش": true, "لباس": true,
+	"رنگ": true, "فروشگاه": true, "فروشنده": true, "مشتری": true, "راننده": true,
+	"کارمند": true, "پشتیبانی": true, "خدمات": true, "امکانات": true, "اطلاعات": true,
+	"تبلیغات": true, "میز": true, "میدان": true,
+
+	// People, places and time
+	"آدم": true, "مرد": true, "زن": true, "دختر": true, "پسر": true, "بچه": true,
+	"دوست": true, "مردم": true, "آقا": true, "خانم": true, "دانشجو": true, "استاد": true,
+	"دکتر": true, "خانه": true, "شهر": true, "کشور": true, "خیابان": true, "تهران": true,
+	"روز": true, "شب": true, "هفته": true, "ماه": true, "سال": true, "ساعت": true,
+	"دقیقه": true, "وقت": true, "زمان": true, "بار": true, "کار": true, "دست": true,
+	"اسم": true, "کتاب": true, "دفتر": true, "درخت": true, "چتر": true, "کبوتر": true,
+	"انگشتر": true, "بستر": true, "شتر": true,
+
+	// Adjectives
+	"خوب": true, "بد": true, "عالی": true, "ضعیف": true, "بزرگ": true, "کوچک": true,
+	"سرد": true, "گرم": true, "داغ": true, "تازه": true, "سریع": true, "زیبا": true,
+	"زشت": true, "خوشمزه": true, "بی‌مزه": true, "شور": true, "شیرین": true, "تلخ": true,
+	"ترش": true, "تند": true, "تمیز": true, "کثیف": true, "ارزان": true, "گران": true,
+	"آسان": true, "مهربان": true, "جوان": true, "قوی": true, "زیاد": true, "کم": true,
+	"بیش": true, "نرم": true, "سفت": true, "سالم": true, "خراب": true, "جدید": true,
+	"قدیمی": true, "کهنه": true, "بلند": true, "کوتاه": true, "سبک": true, "سنگین": true,
+	"مناسب": true, "راضی": true, "ناراضی": true, "محشر": true, "افتضاح": true,
+	"شدید": true, "خسته": true, "تنها": true,
+
+	// Words ending in ها that are not plurals
+	"بها": true, "رها": true, "انتها": true,
+}
@@ -0,0 +1,161 @@
+package go_sdk
+
+import (
+	"context"
+	"math"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Priority selects the lane a call is throttled in
+type Priority int
+
+const (
+	// PriorityInteractive is for latency sensitive calls, such as a user waiting
+	// on a page. It is the default for single-text calls.
+	PriorityInteractive Priority = iota
+	// PriorityBulk is for background jobs. It is the default for AnalyzeBatch,
+	// AnalyzeAll and AnalyzeWithRetry.
+	PriorityBulk
+)
+
+// String returns the lower-case name of the priority
+func (p Priority) String() string {
+	if p == PriorityBulk {
+		return "bulk"
+	}
+	return "interactive"
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a context whose calls are throttled in the lane of p
+func WithPriority(ctx context.Context, p Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, p)
+}
+
+// PriorityFromContext returns the priority set with WithPriority, if any
+func PriorityFromContext(ctx context.Context) (Priority, bool) {
+	p, ok := ctx.Value(priorityKey{}).(Priority)
+	return p, ok
+}
+
+// defaultPriority tags ctx with p unless it already has a priority
+func defaultPriority(ctx context.Context, p Priority) context.Context {
+	if _, ok := PriorityFromContext(ctx); ok {
+		return ctx
+	}
+	return WithPriority(ctx, p)
+}
+
+// LaneLimits throttles the calls of one priority
+type LaneLimits struct {
+	// Rate is the sustained number of calls per second. Zero means unlimited.
+	Rate float64
+	// Burst is the number of calls that may start at once. Defaults to Rate
+	// rounded up, and at least 1.
+	Burst int
+	// MaxInFlight caps the number of concurrent unary calls. Zero means unlimited.
+	MaxInFlight int
+}
+
+// lane is a token bucket and bulkhead for one priority
+type lane struct {
+	limiter *rate.Limiter
+	slots   chan struct{}
+}
+
+func newLane(limits *LaneLimits) *lane {
+	if limits == nil {
+		return nil
+	}
+	l := &lane{}
+	if limits.Rate > 0 {
+		burst := limits.Burst
+		if burst <= 0 {
+			burst = max(1, int(math.Ceil(limits.Rate)))
+		}
+		l.limiter = rate.NewLimiter(rate.Limit(limits.Rate), burst)
+	}
+	if limits.MaxInFlight > 0 {
+		l.slots = make(chan struct{}, limits.MaxInFlight)
+	}
+	return l
+}
+
+// acquire waits for a token and, when hold is set, a slot. The returned func
+// releases the slot.
+func (l *lane) acquire(ctx context.Context, hold bool) (func(), error) {
+	if l.limiter != nil {
+		if err := l.limiter.Wait(ctx); err != nil {
+			if ctx.Err() != nil {
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+			// The wait would outlast the deadline of ctx
+			return nil, status.FromContextError(context.DeadlineExceeded).Err()
+		}
+	}
+	if !hold || l.slots == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.slots <- struct{}{}:
+		return func() { <-l.slots }, nil
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// lanes throttles calls per priority. Lanes without limits are nil.
+type lanes struct {
+	interactive *lane
+	bulk        *lane
+}
+
+func newLanes(cfg Config) *lanes {
+	if cfg.InteractiveLimits == nil && cfg.BulkLimits == nil {
+		return nil
+	}
+	return &lanes{
+		interactive: newLane(cfg.InteractiveLimits),
+		bulk:        newLane(cfg.BulkLimits),
+	}
+}
+
+func (ls *lanes) lane(ctx context.Context) *lane {
+	if p, _ := PriorityFromContext(ctx); p == PriorityBulk {
+		return ls.bulk
+	}
+	return ls.interactive
+}
+
+// unaryInterceptor holds a token and a slot of the call's lane for every attempt
+func (ls *lanes) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		l := ls.lane(ctx)
+		if l == nil || !isNLPMethod(method) {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		release, err := l.acquire(ctx, true)
+		if err != nil {
+			return err
+		}
+		defer release()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// streamInterceptor takes a token of the stream's lane when it is opened.
+// Streams do not hold a slot, as they may stay open indefinitely.
+func (ls *lanes) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if l := ls.lane(ctx); l != nil && isNLPMethod(method) {
+			if _, err := l.acquire(ctx, false); err != nil {
+				return nil, err
+			}
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
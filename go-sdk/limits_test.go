@@ -0,0 +1,145 @@
+package go_sdk_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBulkTrafficDoesNotQueueInteractiveCalls(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	arrived := make(chan struct{}, 6)
+	release := make(chan struct{})
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	srv.On("کند").Do(func(ctx context.Context) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+
+		arrived <- struct{}{}
+		select {
+		case <-release:
+		case <-ctx.Done():
+		}
+	})
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:    5 * time.Second,
+		BulkLimits: &go_sdk.LaneLimits{MaxInFlight: 2},
+	})
+
+	texts := []string{"کند", "کند", "کند", "کند", "کند", "کند"}
+	results := client.AnalyzeAll(context.Background(), texts, go_sdk.AnalyzeAllOptions{Concurrency: len(texts)})
+	for i := 0; i < 2; i++ {
+		select {
+		case <-arrived:
+		case <-time.After(5 * time.Second):
+			t.Fatal("bulk calls did not reach the server")
+		}
+	}
+
+	// Both bulk slots are held until release, so this only passes in its own lane
+	if _, err := client.Analyze(context.Background(), "سریع"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	select {
+	case <-arrived:
+		t.Error("a third bulk call got past the bulkhead")
+	default:
+	}
+
+	close(release)
+	for res := range results {
+		if res.Err != nil {
+			t.Errorf("item %d: %v", res.Index, res.Err)
+		}
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if maxInFlight != 2 {
+		t.Errorf("expected at most 2 bulk calls at once, got %d", maxInFlight)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:           5 * time.Second,
+		InteractiveLimits: &go_sdk.LaneLimits{Rate: 20, Burst: 1},
+	})
+
+	start := time.Now()
+	for i := 0; i < 5; i++ {
+		if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+	}
+	// A lower bound only, as a slow machine can only make the calls take longer
+	if d := time.Since(start); d < 180*time.Millisecond {
+		t.Errorf("5 calls at 20/s finished in %s", d)
+	}
+}
+
+func TestRateLimitFailsCallsThatWouldOutlastTheDeadline(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:           5 * time.Second,
+		InteractiveLimits: &go_sdk.LaneLimits{Rate: 0.01, Burst: 1},
+	})
+
+	if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	// The next token is 100s away, far past the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.Analyze(ctx, "خوب")
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Fatalf("expected DeadlineExceeded when the deadline is shorter than the wait, got %v", err)
+	}
+	if ctx.Err() != nil {
+		t.Error("expected the call to fail without waiting for the deadline")
+	}
+	if n := srv.Count("خوب"); n != 1 {
+		t.Errorf("expected the throttled call not to reach the server, got %d requests", n)
+	}
+}
+
+func TestAnalyzeWithRetryDefaultsToBulk(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	var got []go_sdk.Priority
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		UnaryInterceptors: []grpc.UnaryClientInterceptor{
+			func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+				p, _ := go_sdk.PriorityFromContext(ctx)
+				got = append(got, p)
+				return invoker(ctx, method, req, reply, cc, opts...)
+			},
+		},
+	})
+
+	if _, err := client.AnalyzeWithRetry(context.Background(), "خوب", 2); err != nil {
+		t.Fatalf("AnalyzeWithRetry() error = %v", err)
+	}
+	ctx := go_sdk.WithPriority(context.Background(), go_sdk.PriorityInteractive)
+	if _, err := client.AnalyzeWithRetry(ctx, "خوب", 2); err != nil {
+		t.Fatalf("AnalyzeWithRetry() error = %v", err)
+	}
+	if len(got) != 2 || got[0] != go_sdk.PriorityBulk || got[1] != go_sdk.PriorityInteractive {
+		t.Errorf("priorities = %v, want [bulk interactive]", got)
+	}
+}
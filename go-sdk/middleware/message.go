@@ -0,0 +1,150 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RequestMutator modifies an outgoing request message in place
+type RequestMutator func(method string, req any)
+
+// ResponseValidator checks an incoming response message
+type ResponseValidator func(method string, resp any) error
+
+// UnaryMutateRequest applies fn to the request of every unary call before it is sent
+func UnaryMutateRequest(fn RequestMutator) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		fn(method, req)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamMutateRequest applies fn to every message sent on a stream
+func StreamMutateRequest(fn RequestMutator) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &messageStream{ClientStream: stream, method: method, mutate: fn}, nil
+	}
+}
+
+// UnaryValidateResponse checks the response of every successful unary call with
+// fn. A rejected response fails the call with codes.Internal.
+func UnaryValidateResponse(fn ResponseValidator) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if err := invoker(ctx, method, req, reply, cc, opts...); err != nil {
+			return err
+		}
+		return validate(fn, method, reply)
+	}
+}
+
+// StreamValidateResponse checks every message received on a stream with fn
+func StreamValidateResponse(fn ResponseValidator) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &messageStream{ClientStream: stream, method: method, validate: fn}, nil
+	}
+}
+
+// SentimentRequests returns a RequestMutator applying fn to every sentiment
+// request, including batch items and stream messages
+func SentimentRequests(fn func(*pb.SentimentRequest)) RequestMutator {
+	return func(_ string, req any) {
+		switch req := req.(type) {
+		case *pb.SentimentRequest:
+			fn(req)
+		case *pb.SentimentBatchRequest:
+			for _, item := range req.Items {
+				fn(item)
+			}
+		case *pb.SentimentStreamRequest:
+			if req.Request != nil {
+				fn(req.Request)
+			}
+		}
+	}
+}
+
+// ValidSentiment is a ResponseValidator rejecting sentiment responses without a
+// label or with a score or class probability outside [0, 1]. Batch and stream
+// items that failed on the server are not checked.
+func ValidSentiment(_ string, resp any) error {
+	switch resp := resp.(type) {
+	case *pb.SentimentResponse:
+		return validSentiment(resp)
+	case *pb.SentimentBatchResponse:
+		for _, result := range resp.Results {
+			if r := result.GetResponse(); r != nil {
+				if err := validSentiment(r); err != nil {
+					return fmt.Errorf("item %d: %w", result.Index, err)
+				}
+			}
+		}
+	case *pb.SentimentStreamResponse:
+		if r := resp.GetResponse(); r != nil {
+			if err := validSentiment(r); err != nil {
+				return fmt.Errorf("stream item %q: %w", resp.Id, err)
+			}
+		}
+	}
+	return nil
+}
+
+func validSentiment(resp *pb.SentimentResponse) error {
+	if resp.Label == "" {
+		return errors.New("missing label")
+	}
+	if resp.Score < 0 || resp.Score > 1 {
+		return fmt.Errorf("score %v out of range", resp.Score)
+	}
+	for label, p := range resp.Probabilities {
+		if p < 0 || p > 1 {
+			return fmt.Errorf("probability %v of %q out of range", p, label)
+		}
+	}
+	return nil
+}
+
+func validate(fn ResponseValidator, method string, resp any) error {
+	if err := fn(method, resp); err != nil {
+		return status.Errorf(codes.Internal, "invalid response from %s: %v", method, err)
+	}
+	return nil
+}
+
+// messageStream applies a mutator to sent and a validator to received messages
+type messageStream struct {
+	grpc.ClientStream
+	method   string
+	mutate   RequestMutator
+	validate ResponseValidator
+}
+
+func (s *messageStream) SendMsg(m any) error {
+	if s.mutate != nil {
+		s.mutate(s.method, m)
+	}
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *messageStream) RecvMsg(m any) error {
+	if err := s.ClientStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.validate != nil {
+		return validate(s.validate, s.method, m)
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+// Package middleware provides client interceptors for the Go SDK that add
+// logging, metrics, metadata, request mutation and response validation to
+// every NLPManager call. Install them through the client Config:
+//
+//	client, err := go_sdk.NewClientWithConfig(go_sdk.Config{
+//		Address: "localhost:50051",
+//		UnaryInterceptors: []grpc.UnaryClientInterceptor{
+//			middleware.UnaryLogging(slog.Default()),
+//			middleware.UnaryValidateResponse(middleware.ValidSentiment),
+//		},
+//	})
+//
+// Interceptors run in the order they are listed, the first one outermost.
+package middleware
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Call describes a finished RPC
+type Call struct {
+	// Method is the full gRPC method name, e.g. "/nlp.NLPManager/AnalyzeSentiment"
+	Method string
+	// Code is the status code the call finished with
+	Code codes.Code
+	// Duration is the time from starting the call until it finished
+	Duration time.Duration
+	// Stream reports whether the call was a streaming RPC
+	Stream bool
+	// Err is the error the call failed with, if any
+	Err error
+}
+
+// Observer is called once for every finished call
+type Observer func(Call)
+
+// UnaryMetrics reports every unary call to observe
+func UnaryMetrics(observe Observer) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		observe(Call{
+			Method:   method,
+			Code:     status.Code(err),
+			Duration: time.Since(start),
+			Err:      err,
+		})
+		return err
+	}
+}
+
+// StreamMetrics reports every streaming call to observe once the stream ends
+func StreamMetrics(observe Observer) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		start := time.Now()
+		finish := func(err error) {
+			observe(Call{
+				Method:   method,
+				Code:     status.Code(err),
+				Duration: time.Since(start),
+				Stream:   true,
+				Err:      err,
+			})
+		}
+
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		if err != nil {
+			finish(err)
+			return nil, err
+		}
+		return &observedStream{ClientStream: stream, finish: finish}, nil
+	}
+}
+
+// UnaryLogging logs every unary call with its method, status code and duration.
+// Failed calls are logged at warning level.
+func UnaryLogging(logger *slog.Logger) grpc.UnaryClientInterceptor {
+	return UnaryMetrics(logCall(logger))
+}
+
+// StreamLogging logs every streaming call once the stream ends
+func StreamLogging(logger *slog.Logger) grpc.StreamClientInterceptor {
+	return StreamMetrics(logCall(logger))
+}
+
+func logCall(logger *slog.Logger) Observer {
+	return func(call Call) {
+		level := slog.LevelInfo
+		if call.Err != nil {
+			level = slog.LevelWarn
+		}
+		attrs := []slog.Attr{
+			slog.String("method", call.Method),
+			slog.String("code", call.Code.String()),
+			slog.Duration("duration", call.Duration),
+		}
+		if call.Err != nil {
+			attrs = append(attrs, slog.String("error", status.Convert(call.Err).Message()))
+		}
+		logger.LogAttrs(context.Background(), level, "zennlp call", attrs...)
+	}
+}
+
+// MetadataFunc returns the metadata to attach to an outgoing call. An error
+// aborts the call before it is sent.
+type MetadataFunc func(ctx context.Context, method string) (metadata.MD, error)
+
+// Static returns a MetadataFunc attaching the given key/value pairs to every call
+func Static(kv ...string) MetadataFunc {
+	md := metadata.Pairs(kv...)
+	return func(context.Context, string) (metadata.MD, error) {
+		return md, nil
+	}
+}
+
+// UnaryMetadata attaches the metadata returned by fn to every unary call, e.g.
+// tenant headers or short-lived auth tokens
+func UnaryMetadata(fn MetadataFunc) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx, err := appendMetadata(ctx, method, fn)
+		if err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// StreamMetadata attaches the metadata returned by fn to every streaming call
+func StreamMetadata(fn MetadataFunc) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, err := appendMetadata(ctx, method, fn)
+		if err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
+func appendMetadata(ctx context.Context, method string, fn MetadataFunc) (context.Context, error) {
+	md, err := fn(ctx, method)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "failed to get metadata for %s: %v", method, err)
+	}
+	for key, values := range md {
+		for _, value := range values {
+			ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+		}
+	}
+	return ctx, nil
+}
+
+// observedStream calls finish once, when the stream ends
+type observedStream struct {
+	grpc.ClientStream
+	once   sync.Once
+	finish func(error)
+}
+
+func (s *observedStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			s.once.Do(func() { s.finish(nil) })
+		} else {
+			s.once.Do(func() { s.finish(err) })
+		}
+	}
+	return err
+}
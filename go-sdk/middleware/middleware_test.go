@@ -0,0 +1,137 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/middleware"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryChain(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("سلام").Fail(codes.Unavailable, "warming up").Times(2)
+
+	var mu sync.Mutex
+	var calls []middleware.Call
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Retry: fastRetry(),
+		UnaryInterceptors: []grpc.UnaryClientInterceptor{
+			middleware.UnaryMetrics(func(c middleware.Call) {
+				mu.Lock()
+				defer mu.Unlock()
+				calls = append(calls, c)
+			}),
+			middleware.UnaryMetadata(middleware.Static("x-tenant", "acme")),
+			middleware.UnaryMutateRequest(middleware.SentimentRequests(func(req *pb.SentimentRequest) {
+				req.Text = strings.TrimSpace(req.Text)
+			})),
+		},
+	})
+
+	if _, err := client.Analyze(context.Background(), "  سلام "); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if n := srv.Count("سلام"); n != 3 {
+		t.Errorf("expected 3 attempts with the trimmed text, got %d", n)
+	}
+	for _, req := range srv.Requests() {
+		if got := req.Metadata.Get("x-tenant"); len(got) != 1 || got[0] != "acme" {
+			t.Errorf("expected x-tenant metadata on every attempt, got %v", got)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(calls) != 1 {
+		t.Fatalf("expected one observed call around the retries, got %d", len(calls))
+	}
+	if calls[0].Method != pb.NLPManager_AnalyzeSentiment_FullMethodName || calls[0].Code != codes.OK {
+		t.Errorf("unexpected call %+v", calls[0])
+	}
+}
+
+func TestValidSentimentRejectsInvalidResponses(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("بد").Return("negative", 1.5)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Retry:             fastRetry(),
+		UnaryInterceptors: []grpc.UnaryClientInterceptor{middleware.UnaryValidateResponse(middleware.ValidSentiment)},
+	})
+
+	if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	_, err := client.Analyze(context.Background(), "بد")
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal for an out of range score, got %v", err)
+	}
+
+	_, err = client.AnalyzeBatch(context.Background(), []string{"خوب", "بد"})
+	if status.Code(err) != codes.Internal {
+		t.Fatalf("expected Internal for an invalid batch item, got %v", err)
+	}
+}
+
+func TestStreamChain(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+
+	observed := make(chan middleware.Call, 1)
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		StreamInterceptors: []grpc.StreamClientInterceptor{
+			middleware.StreamMetrics(func(c middleware.Call) { observed <- c }),
+			middleware.StreamMetadata(middleware.Static("x-tenant", "acme")),
+			middleware.StreamMutateRequest(middleware.SentimentRequests(func(req *pb.SentimentRequest) {
+				req.Lang = "fa-IR"
+			})),
+			middleware.StreamValidateResponse(middleware.ValidSentiment),
+		},
+	})
+
+	stream, err := client.Stream(context.Background())
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	defer stream.Close()
+
+	if err := stream.Send("1", "عالی"); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	stream.CloseSend()
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if res.Err != nil {
+			t.Fatalf("unexpected item error %v", res.Err)
+		}
+	}
+
+	reqs := srv.Requests()
+	if len(reqs) != 1 || reqs[0].Lang != "fa-IR" || strings.Join(reqs[0].Metadata.Get("x-tenant"), ",") != "acme" {
+		t.Errorf("unexpected requests %+v", reqs)
+	}
+	call := <-observed
+	if !call.Stream || call.Code != codes.OK {
+		t.Errorf("unexpected call %+v", call)
+	}
+}
+
+func fastRetry() *go_sdk.RetryPolicy {
+	return &go_sdk.RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond, BackoffMultiplier: 1}
+}
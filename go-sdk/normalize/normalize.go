@@ -0,0 +1,375 @@
+// Package normalize cleans up Persian text before it is analyzed or cached.
+// Texts typed on Arabic keyboards, copied from the web or written with mixed
+// digits score and cache differently from the same text written properly:
+//
+//	n := normalize.New(normalize.Default())
+//	n.Normalize("كتاب ها را مي خوانم ؟") // "کتاب‌ها را می‌خوانم؟"
+//
+// Every step can be turned off through Options.
+package normalize
+
+import (
+	"strings"
+	"unicode"
+)
+
+// zwnj is the zero-width non-joiner, the Persian half-space
+const zwnj = '\u200c'
+
+// Digits selects the script digits are converted to
+type Digits int
+
+const (
+	// DigitsKeep leaves digits as written
+	DigitsKeep Digits = iota
+	// DigitsPersian converts Latin and Arabic-Indic digits to Persian ones (۰-۹)
+	DigitsPersian
+	// DigitsLatin converts Persian and Arabic-Indic digits to Latin ones (0-9)
+	DigitsLatin
+)
+
+// Options selects the normalization steps
+type Options struct {
+	// UnifyCharacters replaces Arabic letters with their Persian forms: ي and ى
+	// with ی, ك with ک and ە with ه
+	UnifyCharacters bool
+	// Digits converts digits to a single script. Words with Latin letters,
+	// URLs, emails and numbers following a Latin word, as in "iPhone 13", keep
+	// their digits so they still match elsewhere.
+	Digits Digits
+	// RemoveDiacritics drops short vowels, tanwin, shadda and sukun
+	RemoveDiacritics bool
+	// RemoveTatweel drops the kashida (ـ) used to stretch words
+	RemoveTatweel bool
+	// FixZWNJ joins the prefixes می and نمی and suffixes such as ها and های to
+	// their word with a half-space, and drops half-spaces at word edges. تر and
+	// ترین are only joined to known adjectives.
+	FixZWNJ bool
+	// Whitespace collapses runs of spaces and blank lines, trims the text and
+	// removes invisible characters such as direction marks
+	Whitespace bool
+	// Punctuation uses the Persian comma, semicolon and question mark after
+	// Persian words and fixes the spacing around punctuation marks
+	Punctuation bool
+}
+
+// Default returns options enabling every step, with Persian digits
+func Default() Options {
+	return Options{
+		UnifyCharacters:  true,
+		Digits:           DigitsPersian,
+		RemoveDiacritics: true,
+		RemoveTatweel:    true,
+		FixZWNJ:          true,
+		Whitespace:       true,
+		Punctuation:      true,
+	}
+}
+
+// Normalizer applies a fixed set of steps. It is safe for concurrent use.
+type Normalizer struct {
+	opts Options
+}
+
+// New creates a normalizer applying the steps enabled in opts
+func New(opts Options) *Normalizer {
+	return &Normalizer{opts: opts}
+}
+
+var defaultNormalizer = New(Default())
+
+// Normalize normalizes text with the Default options
+func Normalize(text string) string {
+	return defaultNormalizer.Normalize(text)
+}
+
+// Normalize returns text with the enabled steps applied
+func (n *Normalizer) Normalize(text string) string {
+	text = n.mapRunes(text)
+	if n.opts.Digits != DigitsKeep {
+		text = convertDigits(text, n.opts.Digits)
+	}
+	if n.opts.Whitespace {
+		text = collapseWhitespace(text)
+	}
+	if n.opts.Punctuation {
+		text = fixPunctuation(text)
+	}
+	if n.opts.FixZWNJ {
+		text = fixZWNJ(text)
+	}
+	return text
+}
+
+// mapRunes applies the steps that replace or drop single characters
+func (n *Normalizer) mapRunes(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	for _, r := range text {
+		if n.opts.UnifyCharacters {
+			switch r {
+			case 'ي', 'ى':
+				r = 'ی'
+			case 'ك':
+				r = 'ک'
+			case 'ە':
+				r = 'ه'
+			}
+		}
+		if n.opts.RemoveDiacritics && isDiacritic(r) {
+			continue
+		}
+		if n.opts.RemoveTatweel && r == '\u0640' {
+			continue
+		}
+		if n.opts.Whitespace {
+			if isInvisible(r) {
+				continue
+			}
+			if r != '\n' && unicode.IsSpace(r) {
+				r = ' '
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// convertDigits converts the digits of every word that is not Latin text
+func convertDigits(text string, digits Digits) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	afterLatin := false
+	for text != "" {
+		start := strings.IndexFunc(text, func(r rune) bool { return !unicode.IsSpace(r) })
+		if start < 0 {
+			b.WriteString(text)
+			break
+		}
+		b.WriteString(text[:start])
+		text = text[start:]
+		end := strings.IndexFunc(text, unicode.IsSpace)
+		if end < 0 {
+			end = len(text)
+		}
+		word := text[:end]
+		text = text[end:]
+
+		latin := isLatinWord(word)
+		if latin || afterLatin && !strings.ContainsFunc(word, unicode.IsLetter) {
+			b.WriteString(word)
+		} else {
+			b.WriteString(strings.Map(func(r rune) rune { return convertDigit(r, digits) }, word))
+		}
+		afterLatin = latin
+	}
+	return b.String()
+}
+
+// isLatinWord reports words with Latin letters, URLs and emails
+func isLatinWord(w string) bool {
+	return strings.ContainsFunc(w, func(r rune) bool { return unicode.Is(unicode.Latin, r) }) ||
+		strings.Contains(w, "@") || strings.Contains(w, "://")
+}
+
+func convertDigit(r rune, digits Digits) rune {
+	switch digits {
+	case DigitsPersian:
+		if r >= '0' && r <= '9' {
+			return r + '۰' - '0'
+		}
+		if r >= '٠' && r <= '٩' {
+			return r + '۰' - '٠'
+		}
+	case DigitsLatin:
+		if r >= '۰' && r <= '۹' {
+			return r - ('۰' - '0')
+		}
+		if r >= '٠' && r <= '٩' {
+			return r - ('٠' - '0')
+		}
+	}
+	return r
+}
+
+// isDiacritic reports Arabic vowel marks, keeping the hamza above that forms ۀ
+func isDiacritic(r rune) bool {
+	return (r >= '\u064b' && r <= '\u0652') || r == '\u0670'
+}
+
+// isInvisible reports zero-width and bidirectional formatting characters other than the ZWNJ
+func isInvisible(r rune) bool {
+	switch {
+	case r == '\u200b', r == '\u200d', r == '\u200e', r == '\u200f', r == '\u00ad', r == '\ufeff':
+		return true
+	case r >= '\u202a' && r <= '\u202e', r >= '\u2066' && r <= '\u2069':
+		return true
+	}
+	return false
+}
+
+// collapseWhitespace turns runs of spaces into one and drops blank lines
+func collapseWhitespace(text string) string {
+	lines := strings.Split(text, "\n")
+	out := lines[:0]
+	for _, line := range lines {
+		if line = strings.Join(strings.Fields(line), " "); line != "" {
+			out = append(out, line)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// persianPunctuation maps Latin marks to the Persian ones used after Persian words
+var persianPunctuation = map[rune]rune{',': '،', ';': '؛', '?': '؟'}
+
+// closers attach to the word before them, openers to the word after them
+const (
+	closers = ".،؛؟!:»)"
+	openers = "«("
+)
+
+// fixPunctuation converts Latin marks after Persian words and moves detached
+// marks to the word they belong to
+func fixPunctuation(text string) string {
+	runes := []rune(text)
+	out := make([]rune, 0, len(runes)+8)
+	persian := false
+	for i, r := range runes {
+		var next rune
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		if p, ok := persianPunctuation[r]; ok && persian {
+			// Keep digit grouping such as 1,000
+			if !(r == ',' && i > 0 && unicode.IsDigit(runes[i-1]) && unicode.IsDigit(next)) {
+				r = p
+			}
+		}
+		if unicode.IsLetter(r) {
+			persian = isPersian(r)
+		}
+
+		switch {
+		case r == ' ' && attaches(runes, i+1):
+			// "خوب ." becomes "خوب."
+			continue
+		case r == ' ' && len(out) > 0 && strings.ContainsRune(openers, out[len(out)-1]):
+			continue
+		}
+		out = append(out, r)
+		if strings.ContainsRune(closers, r) && isPersian(next) {
+			// "خوب،بد" becomes "خوب، بد"
+			out = append(out, ' ')
+		}
+	}
+	return string(out)
+}
+
+// attaches reports whether the mark at i belongs to the word before it. Full
+// stops and colons only do when followed by a space or the end of a line, so
+// words such as ".NET" are kept.
+func attaches(runes []rune, i int) bool {
+	if i >= len(runes) {
+		return false
+	}
+	r := runes[i]
+	if _, ok := persianPunctuation[r]; !ok && !strings.ContainsRune(closers, r) {
+		return false
+	}
+	if r == '.' || r == ':' {
+		return i+1 >= len(runes) || runes[i+1] == ' ' || runes[i+1] == '\n'
+	}
+	return true
+}
+
+// isPersian reports letters of the Arabic script
+func isPersian(r rune) bool {
+	return unicode.IsLetter(r) && unicode.Is(unicode.Arabic, r)
+}
+
+// prefixes are joined to the following word with a half-space
+var prefixes = map[string]bool{"می": true, "نمی": true}
+
+// suffixes are joined to the preceding word with a half-space
+var suffixes = map[string]bool{
+	"ها": true, "های": true, "هایی": true, "هایم": true, "هایت": true, "هایش": true,
+	"هایمان": true, "هایتان": true, "هایشان": true,
+	"تر": true, "تری": true, "ترین": true,
+}
+
+// comparatives are the suffixes only joined to a known adjective, as تر on
+// its own also means wet
+var comparatives = map[string]bool{"تر": true, "تری": true, "ترین": true}
+
+// adjectives take a detached comparative suffix, as in جدید تر
+var adjectives = map[string]bool{
+	"خوب": true, "بد": true, "بزرگ": true, "کوچک": true, "سرد": true, "گرم": true, "داغ": true,
+	"تازه": true, "سریع": true, "زیبا": true, "زشت": true, "خوشمزه": true, "شیرین": true,
+	"تلخ": true, "ترش": true, "تند": true, "تمیز": true, "کثیف": true, "ارزان": true,
+	"گران": true, "آسان": true, "سخت": true, "مهربان": true, "جوان": true, "قوی": true,
+	"ضعیف": true, "نرم": true, "سفت": true, "سالم": true, "جدید": true, "قدیمی": true,
+	"کهنه": true, "بلند": true, "کوتاه": true, "مناسب": true, "راحت": true, "دور": true,
+	"نزدیک": true, "زیاد": true, "کم": true, "بیش": true, "مهم": true, "ساده": true,
+	"خنک": true, "شلوغ": true, "خلوت": true, "دیر": true, "زود": true, "پایین": true, "بالا": true,
+}
+
+// fixZWNJ repairs half-spaces word by word, keeping the lines of text
+func fixZWNJ(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		words := strings.Split(line, " ")
+		out := words[:0]
+		for _, w := range words {
+			w = cleanZWNJ(w)
+			suffix := strings.TrimRightFunc(w, unicode.IsPunct)
+			if n := len(out); n > 0 && joins(out[n-1], suffix) {
+				out[n-1] += string(zwnj) + w
+				continue
+			}
+			if n := len(out); n > 0 && prefixes[out[n-1]] && startsPersian(w) {
+				out[n-1] += string(zwnj) + w
+				continue
+			}
+			out = append(out, w)
+		}
+		lines[i] = strings.Join(out, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// joins reports whether suffix belongs to the word before it. Words that
+// already end in a suffix take no other, so لباس‌ها تر stays apart.
+func joins(word, suffix string) bool {
+	if !suffixes[suffix] || !endsPersian(word) {
+		return false
+	}
+	if i := strings.LastIndex(word, string(zwnj)); i >= 0 && suffixes[word[i+len(string(zwnj)):]] {
+		return false
+	}
+	return !comparatives[suffix] || adjectives[word]
+}
+
+// cleanZWNJ collapses repeated half-spaces and drops those at the edges of a word
+func cleanZWNJ(w string) string {
+	if !strings.ContainsRune(w, zwnj) {
+		return w
+	}
+	for strings.Contains(w, "\u200c\u200c") {
+		w = strings.ReplaceAll(w, "\u200c\u200c", "\u200c")
+	}
+	return strings.Trim(w, "\u200c")
+}
+
+func startsPersian(w string) bool {
+	for _, r := range w {
+		return isPersian(r)
+	}
+	return false
+}
+
+func endsPersian(w string) bool {
+	runes := []rune(w)
+	return len(runes) > 0 && isPersian(runes[len(runes)-1])
+}
@@ -0,0 +1,47 @@
+package normalize_test
+
+import (
+	"testing"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/normalize"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"arabic letters", "كيك خوبي بود", "کیک خوبی بود"},
+		{"digits", "قیمت 12 و ٣٤ هزار", "قیمت ۱۲ و ۳۴ هزار"},
+		{"diacritics and tatweel", "خــیلی عَالِی", "خیلی عالی"},
+		{"whitespace", "  خیلی \t خوب‏\n\n\nبود  ", "خیلی خوب\nبود"},
+		{"prefix", "نمی خواهم و می روم", "نمی‌خواهم و می‌روم"},
+		{"suffixes", "کتاب ها بهتر از فیلم های جدید تر هستند", "کتاب‌ها بهتر از فیلم‌های جدید‌تر هستند"},
+		{"wet", "زمین تر است", "زمین تر است"},
+		{"suffix after a suffix", "لباس ها تر شد", "لباس‌ها تر شد"},
+		{"broken half-spaces", "‌کتاب‌‌ها‌", "کتاب‌ها"},
+		{"punctuation", "خوب بود , ولی گران بود ?", "خوب بود، ولی گران بود؟"},
+		{"spacing", "« عالی »بود.ممنون", "«عالی» بود. ممنون"},
+		{"latin text", "price: 1,000 USD? see example.com", "price: 1,000 USD? see example.com"},
+		{"mixed text", "سفارش 12 برای iPhone 13 به ali99@mail.com و https://shop.ir/p/42 و کد ABC123", "سفارش ۱۲ برای iPhone 13 به ali99@mail.com و https://shop.ir/p/42 و کد ABC123"},
+		{"doc example", "كتاب ها را مي خوانم ؟", "کتاب‌ها را می‌خوانم؟"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalize.Normalize(tt.in); got != tt.want {
+				t.Errorf("Normalize(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptions(t *testing.T) {
+	n := normalize.New(normalize.Options{Digits: normalize.DigitsLatin})
+	if got := n.Normalize("۱۲ ٣٤  كتاب"); got != "12 34  كتاب" {
+		t.Errorf("Normalize() = %q, expected only digits to change", got)
+	}
+	if got := normalize.New(normalize.Options{}).Normalize(" ي ۱ "); got != " ي ۱ " {
+		t.Errorf("Normalize() = %q, expected no change without steps", got)
+	}
+}
@@ -0,0 +1,333 @@
+package go_sdk
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	_ "google.golang.org/grpc/balancer/leastrequest" // registers least_request_experimental
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// poolTarget is the target of the connection carrying the interceptors of a
+// pooled client. It never connects, as every call is sent on a backend connection.
+const poolTarget = "passthrough:///zennlp-pool"
+
+// Balancer selects how calls are spread over backends
+type Balancer int
+
+const (
+	// BalancerRoundRobin sends calls to each backend in turn
+	BalancerRoundRobin Balancer = iota
+	// BalancerLeastRequest sends each call to the less busy of two random backends
+	BalancerLeastRequest
+)
+
+// String returns the lower-case name of the balancer
+func (b Balancer) String() string {
+	if b == BalancerLeastRequest {
+		return "least-request"
+	}
+	return "round-robin"
+}
+
+// loadBalancingConfig is the gRPC policy spreading the calls of one connection
+// over the addresses its target resolves to, e.g. the records of a DNS name
+func (b Balancer) loadBalancingConfig() []any {
+	if b == BalancerLeastRequest {
+		return []any{map[string]any{"least_request_experimental": map[string]any{"choiceCount": 2}}}
+	}
+	return []any{map[string]any{"round_robin": map[string]any{}}}
+}
+
+// serviceConfig renders the gRPC service config of every connection
+func serviceConfig(retry RetryPolicy, balancer Balancer) string {
+	cfg := map[string]any{"loadBalancingConfig": balancer.loadBalancingConfig()}
+	if methods := retry.methodConfig(); methods != nil {
+		cfg["methodConfig"] = methods
+	}
+	out, _ := json.Marshal(cfg)
+	return string(out)
+}
+
+// OutlierEjection takes backends that keep failing out of rotation for a while
+type OutlierEjection struct {
+	// ConsecutiveFailures is the number of failed calls in a row that ejects a
+	// backend. Defaults to 5.
+	ConsecutiveFailures int
+	// BaseEjectionTime is how long a backend stays out the first time. Every
+	// further ejection lasts one BaseEjectionTime longer. Defaults to 30s.
+	BaseEjectionTime time.Duration
+	// MaxEjectionTime caps the time a backend stays out. Defaults to 5m.
+	MaxEjectionTime time.Duration
+	// MaxEjectionPercent caps the share of backends out at once. At least one
+	// backend always stays in rotation. Defaults to 50.
+	MaxEjectionPercent int
+	// FailureCodes are the status codes counted as failures. Other errors
+	// are answers from a working backend. Defaults to Unavailable,
+	// DeadlineExceeded, Internal and Unknown.
+	FailureCodes []codes.Code
+}
+
+// withDefaults fills zero fields of the policy
+func (p OutlierEjection) withDefaults() OutlierEjection {
+	if p.ConsecutiveFailures <= 0 {
+		p.ConsecutiveFailures = 5
+	}
+	if p.BaseEjectionTime <= 0 {
+		p.BaseEjectionTime = 30 * time.Second
+	}
+	if p.MaxEjectionTime < p.BaseEjectionTime {
+		p.MaxEjectionTime = max(5*time.Minute, p.BaseEjectionTime)
+	}
+	if p.MaxEjectionPercent <= 0 {
+		p.MaxEjectionPercent = 50
+	}
+	if p.FailureCodes == nil {
+		p.FailureCodes = []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown}
+	}
+	return p
+}
+
+func (p OutlierEjection) failure(err error) bool {
+	code := status.Code(err)
+	for _, c := range p.FailureCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// BackendStatus describes a backend of the client
+type BackendStatus struct {
+	// Target is the entry of Config.Addresses the backend was created from
+	Target string
+	// InFlight is the number of calls and streams currently using the backend
+	InFlight int
+	// Ejected is set while the backend is out of rotation after repeated failures
+	Ejected bool
+}
+
+// backend is a target with a pool of connections
+type backend struct {
+	target   string
+	conns    []*grpc.ClientConn
+	next     atomic.Uint32
+	inFlight atomic.Int64
+
+	// Guarded by the mutex of the pool
+	failures     int
+	ejections    int
+	ejectedUntil time.Time
+}
+
+func (b *backend) conn() *grpc.ClientConn {
+	return b.conns[int(b.next.Add(1))%len(b.conns)]
+}
+
+// pool spreads the attempts of calls over backends, ejecting outliers
+type pool struct {
+	balancer Balancer
+	ejection *OutlierEjection
+	backends []*backend
+	next     atomic.Uint32
+	now      func() time.Time
+
+	mu sync.Mutex
+}
+
+func newPool(cfg Config) *pool {
+	p := &pool{balancer: cfg.Balancer, now: time.Now}
+	if cfg.OutlierEjection != nil {
+		policy := cfg.OutlierEjection.withDefaults()
+		p.ejection = &policy
+	}
+	return p
+}
+
+// dial opens size connections to every target
+func (p *pool) dial(ctx context.Context, targets []string, size int, opts []grpc.DialOption) error {
+	for _, target := range targets {
+		b := &backend{target: target}
+		p.backends = append(p.backends, b)
+		for i := 0; i < size; i++ {
+			conn, err := grpc.DialContext(ctx, target, opts...)
+			if err != nil {
+				return fmt.Errorf("failed to connect to %s: %w", target, err)
+			}
+			b.conns = append(b.conns, conn)
+		}
+	}
+	return nil
+}
+
+// pick selects the backend of the next attempt among those in rotation
+func (p *pool) pick() *backend {
+	candidates := p.backends
+	if p.ejection != nil {
+		candidates = p.available()
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	if p.balancer == BalancerLeastRequest {
+		i := rand.IntN(len(candidates))
+		j := rand.IntN(len(candidates) - 1)
+		if j >= i {
+			j++
+		}
+		if candidates[j].inFlight.Load() < candidates[i].inFlight.Load() {
+			return candidates[j]
+		}
+		return candidates[i]
+	}
+	return candidates[int(p.next.Add(1))%len(candidates)]
+}
+
+// available returns the backends that are not ejected, or all of them if none is left
+func (p *pool) available() []*backend {
+	now := p.now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]*backend, 0, len(p.backends))
+	for _, b := range p.backends {
+		if !now.Before(b.ejectedUntil) {
+			out = append(out, b)
+		}
+	}
+	if len(out) == 0 {
+		return p.backends
+	}
+	return out
+}
+
+// start marks an attempt on b, returning the func to report its outcome with
+func (p *pool) start(b *backend) func(error) {
+	b.inFlight.Add(1)
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			b.inFlight.Add(-1)
+			if p.ejection != nil && !canceled(err) {
+				p.record(b, err)
+			}
+		})
+	}
+}
+
+// record counts consecutive failures of b and ejects it at the threshold
+func (p *pool) record(b *backend, err error) {
+	now := p.now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.ejection.failure(err) {
+		b.failures = 0
+		if !now.Before(b.ejectedUntil) {
+			// Back in rotation and answering again
+			b.ejections = 0
+		}
+		return
+	}
+	b.failures++
+	if b.failures < p.ejection.ConsecutiveFailures || now.Before(b.ejectedUntil) {
+		return
+	}
+
+	ejected := 0
+	for _, other := range p.backends {
+		if now.Before(other.ejectedUntil) {
+			ejected++
+		}
+	}
+	limit := min(len(p.backends)*p.ejection.MaxEjectionPercent/100, len(p.backends)-1)
+	if ejected >= limit {
+		return
+	}
+
+	b.failures = 0
+	b.ejections++
+	b.ejectedUntil = now.Add(min(p.ejection.BaseEjectionTime*time.Duration(b.ejections), p.ejection.MaxEjectionTime))
+}
+
+func (p *pool) status() []BackendStatus {
+	now := p.now()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	out := make([]BackendStatus, len(p.backends))
+	for i, b := range p.backends {
+		out[i] = BackendStatus{
+			Target:   b.target,
+			InFlight: int(b.inFlight.Load()),
+			Ejected:  now.Before(b.ejectedUntil),
+		}
+	}
+	return out
+}
+
+func (p *pool) close() {
+	for _, b := range p.backends {
+		for _, conn := range b.conns {
+			conn.Close()
+		}
+	}
+}
+
+// unaryInterceptor sends each attempt on a backend connection instead of cc.
+// It comes last, so every retry and hedge picks a backend again.
+func (p *pool) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		b := p.pick()
+		done := p.start(b)
+		err := b.conn().Invoke(ctx, method, req, reply, opts...)
+		done(err)
+		return err
+	}
+}
+
+// streamInterceptor opens each stream on a backend connection instead of cc.
+// Streams abandoned before EOF end their attempt when their context is done.
+func (p *pool) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		b := p.pick()
+		done := p.start(b)
+		stream, err := b.conn().NewStream(ctx, desc, method, opts...)
+		if err != nil {
+			done(err)
+			return nil, err
+		}
+		stop := context.AfterFunc(ctx, func() {
+			done(status.FromContextError(ctx.Err()).Err())
+		})
+		return &poolStream{ClientStream: stream, done: done, stop: stop}, nil
+	}
+}
+
+type poolStream struct {
+	grpc.ClientStream
+	done func(error)
+	stop func() bool
+}
+
+func (s *poolStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+	if errors.Is(err, io.EOF) {
+		s.stop()
+		s.done(nil)
+	} else if err != nil {
+		s.stop()
+		s.done(err)
+	}
+	return err
+}
@@ -0,0 +1,126 @@
+package go_sdk_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// newPooledClient connects to the servers by name, as "passthrough:///<name>"
+func newPooledClient(t *testing.T, servers map[string]*zennlptest.Server, cfg go_sdk.Config) *go_sdk.Client {
+	t.Helper()
+	cfg.Insecure = true
+	cfg.Timeout = 5 * time.Second
+	cfg.DialOptions = append(cfg.DialOptions, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return servers[addr].Dial(ctx)
+	}))
+	client, err := go_sdk.NewClientWithConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewClientWithConfig() error = %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+func TestRoundRobinOverAddresses(t *testing.T) {
+	a, b := zennlptest.NewServer(t), zennlptest.NewServer(t)
+	client := newPooledClient(t, map[string]*zennlptest.Server{"a": a, "b": b}, go_sdk.Config{
+		Addresses: []string{"passthrough:///a", "passthrough:///b"},
+	})
+
+	for i := 0; i < 6; i++ {
+		if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+	}
+	if na, nb := a.Count("خوب"), b.Count("خوب"); na != 3 || nb != 3 {
+		t.Errorf("expected calls to alternate, got %d and %d", na, nb)
+	}
+	if backends := client.Backends(); len(backends) != 2 || backends[0].Target != "passthrough:///a" {
+		t.Errorf("unexpected backends %+v", backends)
+	}
+}
+
+func TestOutlierEjection(t *testing.T) {
+	a, b := zennlptest.NewServer(t), zennlptest.NewServer(t)
+	a.OnAny().Fail(codes.Unavailable, "overloaded")
+	client := newPooledClient(t, map[string]*zennlptest.Server{"a": a, "b": b}, go_sdk.Config{
+		Addresses:       []string{"passthrough:///a", "passthrough:///b"},
+		Retry:           &go_sdk.RetryPolicy{MaxAttempts: 1},
+		OutlierEjection: &go_sdk.OutlierEjection{ConsecutiveFailures: 2, BaseEjectionTime: time.Minute},
+	})
+
+	for i := 0; i < 10; i++ {
+		client.Analyze(context.Background(), "خوب")
+	}
+	if n := a.Count("خوب"); n != 2 {
+		t.Errorf("expected the failing backend to be ejected after 2 requests, got %d", n)
+	}
+	if n := b.Count("خوب"); n != 8 {
+		t.Errorf("expected the healthy backend to take the rest, got %d", n)
+	}
+	backends := client.Backends()
+	if !backends[0].Ejected || backends[1].Ejected {
+		t.Errorf("unexpected backends %+v", backends)
+	}
+}
+
+func TestPoolSize(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	var dials atomic.Int32
+	client, err := go_sdk.NewClientWithConfig(go_sdk.Config{
+		Address:  "passthrough:///bufnet",
+		Timeout:  5 * time.Second,
+		Insecure: true,
+		PoolSize: 3,
+		DialOptions: []grpc.DialOption{grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			dials.Add(1)
+			return srv.Dial(ctx)
+		})},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithConfig() error = %v", err)
+	}
+	defer client.Close()
+
+	for i := 0; i < 6; i++ {
+		if _, err := client.Analyze(context.Background(), "خوب"); err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+	}
+	if n := dials.Load(); n != 3 {
+		t.Errorf("expected 3 connections, got %d", n)
+	}
+}
+
+func TestPoolReleasesAbandonedStream(t *testing.T) {
+	a := zennlptest.NewServer(t)
+	client := newPooledClient(t, map[string]*zennlptest.Server{"a": a}, go_sdk.Config{
+		Addresses: []string{"passthrough:///a"},
+	})
+
+	stream, err := client.Stream(context.Background())
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	if n := client.Backends()[0].InFlight; n != 1 {
+		t.Fatalf("expected the open stream to be in flight, got %d", n)
+	}
+	stream.Close()
+
+	// The attempt ends asynchronously once the stream context is done
+	deadline := time.Now().Add(5 * time.Second)
+	for client.Backends()[0].InFlight != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected the abandoned stream to leave the backend, got %+v", client.Backends())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
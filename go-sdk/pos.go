@@ -0,0 +1,137 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// PartOfSpeech is a Universal Dependencies part-of-speech tag
+type PartOfSpeech int
+
+const (
+	// POSUnknown is used for tags the SDK does not know
+	POSUnknown PartOfSpeech = iota
+	POSAdjective
+	POSAdposition
+	POSAdverb
+	POSAuxiliary
+	POSCoordinatingConjunction
+	POSDeterminer
+	POSInterjection
+	POSNoun
+	POSNumeral
+	POSParticle
+	POSPronoun
+	POSProperNoun
+	POSPunctuation
+	POSSubordinatingConjunction
+	POSSymbol
+	POSVerb
+	// POSOther is the tag X, e.g. for URLs and foreign words
+	POSOther
+)
+
+var partOfSpeechNames = map[PartOfSpeech]string{
+	POSUnknown:                  "UNKNOWN",
+	POSAdjective:                "ADJ",
+	POSAdposition:               "ADP",
+	POSAdverb:                   "ADV",
+	POSAuxiliary:                "AUX",
+	POSCoordinatingConjunction:  "CCONJ",
+	POSDeterminer:               "DET",
+	POSInterjection:             "INTJ",
+	POSNoun:                     "NOUN",
+	POSNumeral:                  "NUM",
+	POSParticle:                 "PART",
+	POSPronoun:                  "PRON",
+	POSProperNoun:               "PROPN",
+	POSPunctuation:              "PUNCT",
+	POSSubordinatingConjunction: "SCONJ",
+	POSSymbol:                   "SYM",
+	POSVerb:                     "VERB",
+	POSOther:                    "X",
+}
+
+// String returns the Universal Dependencies tag, e.g. "NOUN" or "ADJ"
+func (p PartOfSpeech) String() string {
+	if name, ok := partOfSpeechNames[p]; ok {
+		return name
+	}
+	return partOfSpeechNames[POSUnknown]
+}
+
+func partOfSpeechFromProto(t pb.PartOfSpeech) PartOfSpeech {
+	switch t {
+	case pb.PartOfSpeech_PART_OF_SPEECH_ADJ:
+		return POSAdjective
+	case pb.PartOfSpeech_PART_OF_SPEECH_ADP:
+		return POSAdposition
+	case pb.PartOfSpeech_PART_OF_SPEECH_ADV:
+		return POSAdverb
+	case pb.PartOfSpeech_PART_OF_SPEECH_AUX:
+		return POSAuxiliary
+	case pb.PartOfSpeech_PART_OF_SPEECH_CCONJ:
+		return POSCoordinatingConjunction
+	case pb.PartOfSpeech_PART_OF_SPEECH_DET:
+		return POSDeterminer
+	case pb.PartOfSpeech_PART_OF_SPEECH_INTJ:
+		return POSInterjection
+	case pb.PartOfSpeech_PART_OF_SPEECH_NOUN:
+		return POSNoun
+	case pb.PartOfSpeech_PART_OF_SPEECH_NUM:
+		return POSNumeral
+	case pb.PartOfSpeech_PART_OF_SPEECH_PART:
+		return POSParticle
+	case pb.PartOfSpeech_PART_OF_SPEECH_PRON:
+		return POSPronoun
+	case pb.PartOfSpeech_PART_OF_SPEECH_PROPN:
+		return POSProperNoun
+	case pb.PartOfSpeech_PART_OF_SPEECH_PUNCT:
+		return POSPunctuation
+	case pb.PartOfSpeech_PART_OF_SPEECH_SCONJ:
+		return POSSubordinatingConjunction
+	case pb.PartOfSpeech_PART_OF_SPEECH_SYM:
+		return POSSymbol
+	case pb.PartOfSpeech_PART_OF_SPEECH_VERB:
+		return POSVerb
+	case pb.PartOfSpeech_PART_OF_SPEECH_X:
+		return POSOther
+	default:
+		return POSUnknown
+	}
+}
+
+// TaggedToken is a token of a text with its part of speech
+type TaggedToken struct {
+	Text string
+	Tag  PartOfSpeech
+	// Lemma is the dictionary form of words, empty for other tokens
+	Lemma string
+	// Start and End are the offsets of the token in the text in runes, End exclusive
+	Start, End int
+}
+
+// TagPartsOfSpeech splits text into tokens and tags each with its part of speech
+func (c *Client) TagPartsOfSpeech(ctx context.Context, text string) ([]TaggedToken, error) {
+	resp, err := c.client.TagPartsOfSpeech(ctx, &pb.TagPartsOfSpeechRequest{
+		Text: text,
+		Lang: "fa",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("part-of-speech tagging failed: %w", err)
+	}
+
+	tokens := make([]TaggedToken, len(resp.Tokens))
+	for i, tok := range resp.Tokens {
+		tokens[i] = TaggedToken{
+			Text:  tok.Text,
+			Tag:   partOfSpeechFromProto(tok.Tag),
+			Lemma: tok.Lemma,
+			Start: int(tok.Start),
+			End:   int(tok.End),
+		}
+	}
+	return tokens, nil
+}
@@ -0,0 +1,47 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestTagPartsOfSpeech(t *testing.T) {
+	client := zennlptest.NewServer(t).Client(t)
+
+	text := "پیتزای داغ و نوشابه‌های خنک خیلی خوب بود."
+	tokens, err := client.TagPartsOfSpeech(context.Background(), text)
+	if err != nil {
+		t.Fatalf("TagPartsOfSpeech() error = %v", err)
+	}
+
+	want := []go_sdk.PartOfSpeech{
+		go_sdk.POSNoun, go_sdk.POSAdjective, go_sdk.POSCoordinatingConjunction, go_sdk.POSNoun,
+		go_sdk.POSAdjective, go_sdk.POSAdverb, go_sdk.POSAdjective, go_sdk.POSAuxiliary, go_sdk.POSPunctuation,
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("TagPartsOfSpeech() = %v, want %d tokens", tokens, len(want))
+	}
+	runes := []rune(text)
+	for i, tok := range tokens {
+		if tok.Tag != want[i] {
+			t.Errorf("tag of %q = %s, want %s", tok.Text, tok.Tag, want[i])
+		}
+		if string(runes[tok.Start:tok.End]) != tok.Text {
+			t.Errorf("offsets of %q select %q", tok.Text, string(runes[tok.Start:tok.End]))
+		}
+	}
+
+	// Adjectives following a noun describe it
+	var pairs []string
+	for i := 1; i < len(tokens); i++ {
+		if tokens[i].Tag == go_sdk.POSAdjective && tokens[i-1].Tag == go_sdk.POSNoun {
+			pairs = append(pairs, tokens[i-1].Lemma+" "+tokens[i].Lemma)
+		}
+	}
+	if len(pairs) != 2 || pairs[0] != "پیتزا داغ" || pairs[1] != "نوشابه خنک" {
+		t.Errorf("unexpected adjective-noun pairs %q", pairs)
+	}
+}
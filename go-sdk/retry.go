@@ -0,0 +1,174 @@
+package go_sdk
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RetryPolicy controls how failed calls are retried.
+//
+// Unary RPCs, which are AnalyzeSentiment, AnalyzeSentimentBatch, Lemmatize,
+// Tokenize, SegmentSentences, ExtractEntities, TagPartsOfSpeech and
+// AnalyzeAspects, are retried by a client interceptor, so every attempt goes
+// through the circuit breaker, rate limits and backend picking and a call can
+// override MaxAttempts. Streaming RPCs, which is StreamSentiment, cannot be
+// retried once messages flow and are retried by gRPC itself through the
+// equivalent service config, which only retries a stream before the server has
+// sent any response.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one. 1 disables retries.
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay between attempts.
+	MaxBackoff time.Duration
+	// BackoffMultiplier grows the delay after every retry.
+	BackoffMultiplier float64
+	// Jitter randomizes each delay by up to this fraction, in [0, 1].
+	Jitter float64
+	// RetryableCodes lists the status codes that are worth retrying. The
+	// default retries server-side failures, including DeadlineExceeded, Internal
+	// and Unknown from the engine, but not errors in the request such as
+	// InvalidArgument, PermissionDenied or Unauthenticated.
+	RetryableCodes []codes.Code
+}
+
+// DefaultRetryPolicy returns the policy used when Config.Retry is nil
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:       4,
+		InitialBackoff:    time.Second,
+		MaxBackoff:        4 * time.Second,
+		BackoffMultiplier: 2,
+		Jitter:            0.2,
+		RetryableCodes: []codes.Code{
+			codes.Unavailable, codes.ResourceExhausted, codes.Aborted,
+			codes.DeadlineExceeded, codes.Internal, codes.Unknown,
+		},
+	}
+}
+
+// withDefaults fills zero fields from DefaultRetryPolicy
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	def := DefaultRetryPolicy()
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 1
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = def.InitialBackoff
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+	if p.BackoffMultiplier < 1 {
+		p.BackoffMultiplier = def.BackoffMultiplier
+	}
+	p.Jitter = math.Max(0, math.Min(1, p.Jitter))
+	if p.RetryableCodes == nil {
+		p.RetryableCodes = def.RetryableCodes
+	}
+	return p
+}
+
+// Retryable reports whether err carries one of the retryable status codes
+func (p RetryPolicy) Retryable(err error) bool {
+	code := status.Code(err)
+	for _, c := range p.RetryableCodes {
+		if c == code {
+			return true
+		}
+	}
+	return false
+}
+
+// Backoff returns the delay to wait before the given retry, starting at 1
+func (p RetryPolicy) Backoff(retry int) time.Duration {
+	d := float64(p.InitialBackoff) * math.Pow(p.BackoffMultiplier, float64(retry-1))
+	d = math.Min(d, float64(p.MaxBackoff))
+	if p.Jitter > 0 {
+		d *= 1 - p.Jitter + 2*p.Jitter*rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// methodConfig renders the policy as gRPC service config method entries for
+// every streaming method of NLPManager. Unary methods are left to
+// retryInterceptor, which would otherwise multiply their attempts.
+func (p RetryPolicy) methodConfig() []any {
+	// gRPC rejects retry policies with fewer than two attempts and caps them at five
+	if p.MaxAttempts < 2 || len(p.RetryableCodes) == 0 {
+		return nil
+	}
+
+	names := make([]any, 0, len(pb.NLPManager_ServiceDesc.Streams))
+	for _, stream := range pb.NLPManager_ServiceDesc.Streams {
+		names = append(names, map[string]string{"service": pb.NLPManager_ServiceDesc.ServiceName, "method": stream.StreamName})
+	}
+	return []any{
+		map[string]any{
+			"name": names,
+			"retryPolicy": map[string]any{
+				"maxAttempts":          min(p.MaxAttempts, 5),
+				"initialBackoff":       durationString(p.InitialBackoff),
+				"maxBackoff":           durationString(p.MaxBackoff),
+				"backoffMultiplier":    p.BackoffMultiplier,
+				"retryableStatusCodes": p.RetryableCodes,
+			},
+		},
+	}
+}
+
+// maxAttemptsOption overrides RetryPolicy.MaxAttempts for a single call
+type maxAttemptsOption struct {
+	grpc.EmptyCallOption
+	attempts int
+}
+
+// retryInterceptor retries unary calls according to the policy
+func retryInterceptor(policy RetryPolicy) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attempts := policy.MaxAttempts
+		for _, o := range opts {
+			if m, ok := o.(maxAttemptsOption); ok {
+				attempts = m.attempts
+			}
+		}
+
+		for attempt := 1; ; attempt++ {
+			err := invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= attempts || !policy.Retryable(err) || ctx.Err() != nil {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return status.FromContextError(ctx.Err()).Err()
+			case <-time.After(policy.Backoff(attempt)):
+			}
+		}
+	}
+}
+
+// timeoutInterceptor applies a default deadline to unary calls whose context has none
+func timeoutInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+func durationString(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
@@ -0,0 +1,77 @@
+package go_sdk
+
+import (
+	"strings"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// Sentiment is the polarity of an analyzed text
+type Sentiment int
+
+const (
+	// SentimentUnknown is used when the engine reports no usable label, e.g. "error"
+	SentimentUnknown Sentiment = iota
+	SentimentPositive
+	SentimentNegative
+	SentimentNeutral
+	SentimentMixed
+)
+
+var sentimentNames = map[Sentiment]string{
+	SentimentUnknown:  "unknown",
+	SentimentPositive: "positive",
+	SentimentNegative: "negative",
+	SentimentNeutral:  "neutral",
+	SentimentMixed:    "mixed",
+}
+
+// String returns the lower-case label used on the wire
+func (s Sentiment) String() string {
+	if name, ok := sentimentNames[s]; ok {
+		return name
+	}
+	return sentimentNames[SentimentUnknown]
+}
+
+// ParseSentiment converts a label such as "positive" into a Sentiment.
+// Unrecognised labels map to SentimentUnknown.
+func ParseSentiment(label string) Sentiment {
+	label = strings.ToLower(strings.TrimSpace(label))
+	for s, name := range sentimentNames {
+		if name == label {
+			return s
+		}
+	}
+	return SentimentUnknown
+}
+
+func sentimentFromProto(label pb.SentimentLabel) Sentiment {
+	switch label {
+	case pb.SentimentLabel_SENTIMENT_LABEL_POSITIVE:
+		return SentimentPositive
+	case pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE:
+		return SentimentNegative
+	case pb.SentimentLabel_SENTIMENT_LABEL_NEUTRAL:
+		return SentimentNeutral
+	case pb.SentimentLabel_SENTIMENT_LABEL_MIXED:
+		return SentimentMixed
+	default:
+		return SentimentUnknown
+	}
+}
+
+func (s Sentiment) proto() pb.SentimentLabel {
+	switch s {
+	case SentimentPositive:
+		return pb.SentimentLabel_SENTIMENT_LABEL_POSITIVE
+	case SentimentNegative:
+		return pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE
+	case SentimentNeutral:
+		return pb.SentimentLabel_SENTIMENT_LABEL_NEUTRAL
+	case SentimentMixed:
+		return pb.SentimentLabel_SENTIMENT_LABEL_MIXED
+	default:
+		return pb.SentimentLabel_SENTIMENT_LABEL_UNSPECIFIED
+	}
+}
@@ -0,0 +1,191 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/lemmatize"
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+)
+
+// AspectAnalyzer finds the aspects a single text talks about and the
+// sentiment it expresses about each
+type AspectAnalyzer interface {
+	AnalyzeAspects(ctx context.Context, text, lang string) ([]*pb.Aspect, error)
+}
+
+// LexiconAspectAnalyzer is a dependency-free analyzer that finds the terms of
+// a taxonomy and scores the words around each with the opinion words, negators
+// and intensifiers of the LexiconScorer. It is meant as a fallback when no
+// aspect-based model is available.
+type LexiconAspectAnalyzer struct {
+	categories map[string]string
+	// longest is the number of tokens of the longest term
+	longest int
+}
+
+// NewLexiconAspectAnalyzer creates an analyzer for the categories of t, e.g.
+// DefaultTaxonomy
+func NewLexiconAspectAnalyzer(t Taxonomy) *LexiconAspectAnalyzer {
+	a := &LexiconAspectAnalyzer{categories: make(map[string]string)}
+	for category, terms := range t {
+		for _, term := range terms {
+			keys := lemmaKeys(tokenize.Tokenize(term))
+			if len(keys) == 0 {
+				continue
+			}
+			a.categories[strings.Join(keys, " ")] = category
+			a.longest = max(a.longest, len(keys))
+		}
+	}
+	return a
+}
+
+// aspect is a term found in the text, from token start to end
+type aspect struct {
+	start, end int
+	category   string
+}
+
+// AnalyzeAspects implements AspectAnalyzer. The sentiment of an aspect is that
+// of its clause, or of the words up to the next aspect when a clause has
+// several. Terms joined by و share their sentiment, as in غذا و نوشابه سرد بود,
+// and a term directly following another is part of it, as in قیمت غذا.
+func (a *LexiconAspectAnalyzer) AnalyzeAspects(ctx context.Context, text, lang string) ([]*pb.Aspect, error) {
+	toks := tokenize.Tokenize(text)
+	found := a.find(lemmaKeys(toks))
+
+	var aspects []*pb.Aspect
+	for _, clause := range aspectClauses(toks, found) {
+		for i, group := range clause.groups {
+			// Opinions before the first group belong to it
+			from, to := clause.start, clause.end
+			if i > 0 {
+				from = group[0].start
+			}
+			if i+1 < len(clause.groups) {
+				to = clause.groups[i+1][0].start
+			}
+			resp := distribution(scoreClause(splitWords(text[toks[from].Start:toks[to-1].End])))
+
+			for _, asp := range group {
+				first, last := toks[asp.start], toks[asp.end-1]
+				aspects = append(aspects, &pb.Aspect{
+					Term:     text[first.Start:last.End],
+					Category: asp.category,
+					Start:    int32(first.RuneStart),
+					End:      int32(last.RuneEnd),
+					Label:    resp.Sentiment,
+					Score:    resp.Score,
+				})
+			}
+		}
+	}
+	return aspects, nil
+}
+
+// find returns the terms of the text in order, longest first. A term directly
+// following another is merged into it.
+func (a *LexiconAspectAnalyzer) find(keys []string) []aspect {
+	var found []aspect
+	for i := 0; i < len(keys); {
+		end, category := a.term(keys, i)
+		if end == 0 {
+			i++
+			continue
+		}
+		if n := len(found); n > 0 && found[n-1].end == i {
+			found[n-1].end = end
+		} else {
+			found = append(found, aspect{start: i, end: end, category: category})
+		}
+		i = end
+	}
+	return found
+}
+
+// term returns the end and category of the longest term at token i
+func (a *LexiconAspectAnalyzer) term(keys []string, i int) (int, string) {
+	for n := min(a.longest, len(keys)-i); n > 0; n-- {
+		if category, ok := a.categories[strings.Join(keys[i:i+n], " ")]; ok {
+			return i + n, category
+		}
+	}
+	return 0, ""
+}
+
+// aspectClause is a clause from token start to end with its aspects, grouped
+// by the terms that are coordinated
+type aspectClause struct {
+	start, end int
+	groups     [][]aspect
+}
+
+// aspectClauses splits the tokens at punctuation and conjunctions, keeping
+// terms joined by و or a comma in one clause, and returns the clauses that
+// have aspects
+func aspectClauses(toks []tokenize.Token, found []aspect) []aspectClause {
+	var clauses []aspectClause
+	cur := aspectClause{}
+	flush := func(end int) {
+		cur.end = end
+		if len(cur.groups) > 0 {
+			clauses = append(clauses, cur)
+		}
+	}
+
+	next := 0
+	for i := 0; i < len(toks); i++ {
+		if next < len(found) && found[next].start == i {
+			asp := found[next]
+			if n := len(cur.groups); n > 0 && coordinated(toks, cur.last().end, i) {
+				cur.groups[n-1] = append(cur.groups[n-1], asp)
+			} else {
+				cur.groups = append(cur.groups, []aspect{asp})
+			}
+			i = asp.end - 1
+			next++
+			continue
+		}
+		if !breaksClause(toks[i]) {
+			continue
+		}
+		// The conjunction joins two terms rather than two clauses
+		if len(cur.groups) > 0 && next < len(found) && coordinated(toks, cur.last().end, found[next].start) {
+			continue
+		}
+		flush(i)
+		cur = aspectClause{start: i + 1}
+	}
+	flush(len(toks))
+	return clauses
+}
+
+// last returns the last aspect of the clause
+func (c *aspectClause) last() aspect {
+	group := c.groups[len(c.groups)-1]
+	return group[len(group)-1]
+}
+
+// coordinated reports whether the tokens from i to j only join two terms
+func coordinated(toks []tokenize.Token, i, j int) bool {
+	if j-i != 1 {
+		return false
+	}
+	return toks[i].Text == "و" || toks[i].Text == "،" || toks[i].Text == ","
+}
+
+func breaksClause(tok tokenize.Token) bool {
+	return tok.Kind == tokenize.Punctuation || clauseBreaks[tok.Text]
+}
+
+// lemmaKeys returns the match keys of the lemmas of toks, so that terms match
+// their inflected forms, as غذا matches غذاها
+func lemmaKeys(toks []tokenize.Token) []string {
+	keys := make([]string, len(toks))
+	for i, tok := range toks {
+		keys[i] = matchKey(lemmatize.Lemma(tok.Text))
+	}
+	return keys
+}
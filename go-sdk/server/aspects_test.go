@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+func TestLexiconAspectAnalyzer(t *testing.T) {
+	type aspect struct {
+		term, category string
+		label          pb.SentimentLabel
+	}
+	const (
+		positive = pb.SentimentLabel_SENTIMENT_LABEL_POSITIVE
+		negative = pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE
+		neutral  = pb.SentimentLabel_SENTIMENT_LABEL_NEUTRAL
+	)
+	tests := []struct {
+		name string
+		text string
+		want []aspect
+	}{
+		{"clauses", "غذا عالی بود ولی پیک خیلی دیر رسید", []aspect{
+			{"غذا", "food", positive}, {"پیک", "delivery", negative},
+		}},
+		{"coordinated terms", "غذا و نوشابه سرد بود", []aspect{
+			{"غذا", "food", negative}, {"نوشابه", "food", negative},
+		}},
+		{"compound term", "قیمت غذا مناسب بود.", []aspect{{"قیمت غذا", "price", positive}}},
+		{"opinion before the term", "خیلی خوشمزه بود پیتزا، ولی ظرفش شکسته بود", []aspect{
+			{"پیتزا", "food", positive}, {"ظرفش", "packaging", negative},
+		}},
+		{"negation", "پیک مودب بود. غذاها سرد نبود", []aspect{
+			{"پیک", "delivery", positive}, {"غذاها", "food", positive},
+		}},
+		{"several terms in a clause", "غذای خوشمزه با بسته‌بندی کثیف", []aspect{
+			{"غذای", "food", positive}, {"بسته‌بندی", "packaging", negative},
+		}},
+		{"no opinion", "غذا رسید. پیک بی‌ادب بود", []aspect{
+			{"غذا", "food", neutral}, {"پیک", "delivery", negative},
+		}},
+		{"no aspects", "سلام، ممنون", nil},
+	}
+
+	a := NewLexiconAspectAnalyzer(DefaultTaxonomy())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := a.AnalyzeAspects(context.Background(), tt.text, "fa")
+			if err != nil {
+				t.Fatalf("AnalyzeAspects() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("AnalyzeAspects(%q) = %v, want %d aspects", tt.text, got, len(tt.want))
+			}
+			runes := []rune(tt.text)
+			for i, w := range tt.want {
+				if got[i].Term != w.term || got[i].Category != w.category || got[i].Label != w.label {
+					t.Errorf("aspect %d = %q %s %v, want %q %s %v", i, got[i].Term, got[i].Category, got[i].Label, w.term, w.category, w.label)
+				}
+				if string(runes[got[i].Start:got[i].End]) != got[i].Term {
+					t.Errorf("offsets of %q select %q", got[i].Term, string(runes[got[i].Start:got[i].End]))
+				}
+			}
+		})
+	}
+}
+
+func TestTaxonomyLoad(t *testing.T) {
+	tax := Taxonomy{}
+	err := tax.Load(strings.NewReader("# support\nService\tپشتیبانی\n\nfood\tکوفته تبریزی\n"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	got, _ := NewLexiconAspectAnalyzer(tax).AnalyzeAspects(context.Background(), "کوفته تبریزی عالی بود ولی پشتیبانی جواب نداد", "fa")
+	if len(got) != 2 || got[0].Category != "food" || got[1].Category != "service" {
+		t.Errorf("unexpected aspects %v", got)
+	}
+
+	if err := tax.Load(strings.NewReader("پیک\n")); err == nil {
+		t.Error("expected an error for a line without a category")
+	}
+}
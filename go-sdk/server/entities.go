@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/lemmatize"
+	"github.com/Mannymz/ZenNLP/go-sdk/normalize"
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+)
+
+// Recognizer finds named entities in a single text
+type Recognizer interface {
+	Recognize(ctx context.Context, text, lang string) ([]*pb.Entity, error)
+}
+
+// Confidence of the rules of the GazetteerRecognizer
+const (
+	patternConfidence   = 0.95
+	gazetteerConfidence = 0.9
+	surnameConfidence   = 0.85
+	cueConfidence       = 0.75
+	firstNameConfidence = 0.7
+)
+
+// GazetteerRecognizer is a dependency-free recognizer based on lists of known
+// names, cue words such as آقای or خیابان and patterns for amounts of money
+// and dates. It is meant as a fallback when no NER model is available.
+type GazetteerRecognizer struct {
+	phrases map[string]pb.EntityType
+	// longest is the number of tokens of the longest phrase
+	longest int
+}
+
+// NewGazetteerRecognizer creates a recognizer for the names of g, e.g.
+// DefaultGazetteer extended with the products of a shop
+func NewGazetteerRecognizer(g Gazetteer) *GazetteerRecognizer {
+	r := &GazetteerRecognizer{phrases: make(map[string]pb.EntityType)}
+	for typ, phrases := range g {
+		for _, phrase := range phrases {
+			var keys []string
+			for _, tok := range tokenize.Tokenize(phrase) {
+				keys = append(keys, matchKey(tok.Text))
+			}
+			if len(keys) == 0 {
+				continue
+			}
+			r.phrases[strings.Join(keys, " ")] = typ
+			r.longest = max(r.longest, len(keys))
+		}
+	}
+	return r
+}
+
+// Recognize implements Recognizer
+func (r *GazetteerRecognizer) Recognize(ctx context.Context, text, lang string) ([]*pb.Entity, error) {
+	toks := tokenize.Tokenize(text)
+	keys := make([]string, len(toks))
+	for i, tok := range toks {
+		keys[i] = matchKey(tok.Text)
+	}
+
+	m := &matcher{r: r, toks: toks, keys: keys}
+	var entities []*pb.Entity
+	for i := 0; i < len(toks); {
+		end, typ, confidence := m.match(i)
+		if end == 0 {
+			i++
+			continue
+		}
+		first, last := toks[m.start], toks[end-1]
+		entities = append(entities, &pb.Entity{
+			Text:       text[first.Start:last.End],
+			Type:       typ,
+			Start:      int32(first.RuneStart),
+			End:        int32(last.RuneEnd),
+			Confidence: confidence,
+		})
+		i = end
+	}
+	return entities, nil
+}
+
+// matcher applies the rules to the tokens of one text
+type matcher struct {
+	r    *GazetteerRecognizer
+	toks []tokenize.Token
+	keys []string
+	// start is the first token of the last match, which differs from where
+	// matching began when a cue word is not part of the entity
+	start int
+}
+
+// match returns the end of the entity starting at token i, or 0 if there is none
+func (m *matcher) match(i int) (end int, typ pb.EntityType, confidence float64) {
+	m.start = i
+	if end := m.money(i); end > 0 {
+		return end, pb.EntityType_ENTITY_TYPE_MONEY, patternConfidence
+	}
+	if end := m.date(i); end > 0 {
+		return end, pb.EntityType_ENTITY_TYPE_DATE, patternConfidence
+	}
+	if end, typ := m.phrase(i); end > 0 {
+		switch {
+		case typ == pb.EntityType_ENTITY_TYPE_PRODUCT:
+			return m.model(end), typ, gazetteerConfidence
+		case typ == pb.EntityType_ENTITY_TYPE_PER && end == i+1:
+			if m.surname(end) {
+				return end + 1, typ, surnameConfidence
+			}
+			return end, typ, firstNameConfidence
+		}
+		return end, typ, gazetteerConfidence
+	}
+	return m.cue(i)
+}
+
+// phrase returns the end and type of the longest known name at token i
+func (m *matcher) phrase(i int) (int, pb.EntityType) {
+	for n := min(m.r.longest, len(m.keys)-i); n > 0; n-- {
+		if typ, ok := m.r.phrases[strings.Join(m.keys[i:i+n], " ")]; ok {
+			return i + n, typ
+		}
+	}
+	return 0, 0
+}
+
+// cue recognizes the name following a title, street or company word. Titles
+// are left out of the entity, the other cue words are part of it.
+func (m *matcher) cue(i int) (int, pb.EntityType, float64) {
+	if !m.name(i + 1) {
+		return 0, 0, 0
+	}
+	key := m.keys[i]
+	switch {
+	case personTitles[key]:
+		m.start = i + 1
+		if m.surname(i + 2) {
+			return i + 3, pb.EntityType_ENTITY_TYPE_PER, cueConfidence
+		}
+		return i + 2, pb.EntityType_ENTITY_TYPE_PER, cueConfidence
+	case locationCues[key]:
+		return i + 2, pb.EntityType_ENTITY_TYPE_LOC, cueConfidence
+	case organizationCues[key]:
+		return i + 2, pb.EntityType_ENTITY_TYPE_ORG, cueConfidence
+	}
+	return 0, 0, 0
+}
+
+// name reports whether token i can be part of a name
+func (m *matcher) name(i int) bool {
+	if i >= len(m.toks) || m.toks[i].Kind != tokenize.Word {
+		return false
+	}
+	key := m.keys[i]
+	return !stopWords[key] && !personTitles[key] && !locationCues[key] && !organizationCues[key]
+}
+
+// surname reports whether token i looks like a Persian family name. Verbs
+// ending in ی, as in گفتی, are not names.
+func (m *matcher) surname(i int) bool {
+	if !m.name(i) || lemmatize.IsVerb(m.toks[i].Text) {
+		return false
+	}
+	for _, suffix := range surnameSuffixes {
+		if strings.HasSuffix(m.keys[i], suffix) && len([]rune(m.keys[i])) > len([]rune(suffix))+1 {
+			return true
+		}
+	}
+	return false
+}
+
+// model extends a product name with the model that follows it, as in آیفون ۱۳ پرو
+func (m *matcher) model(end int) int {
+	for n := 0; n < 2 && end < len(m.toks); n++ {
+		tok := m.toks[end]
+		if tok.Kind != tokenize.Number && !modelWords[m.keys[end]] && !(tok.Kind == tokenize.Word && strings.ContainsFunc(tok.Text, unicode.IsDigit)) {
+			break
+		}
+		end++
+	}
+	return end
+}
+
+// money recognizes an amount followed by a currency, as in ۵۰ هزار تومان, or
+// preceded by a currency sign, as in $20
+func (m *matcher) money(i int) int {
+	if currencySigns[m.keys[i]] && i+1 < len(m.toks) && m.toks[i+1].Kind == tokenize.Number {
+		return i + 2
+	}
+	end := i
+	for end < len(m.toks) && (m.toks[end].Kind == tokenize.Number || numberWords[m.keys[end]] || m.keys[end] == "و" && end > i) {
+		end++
+	}
+	if end == i || m.keys[end-1] == "و" {
+		return 0
+	}
+	if end < len(m.toks) && currencies[m.keys[end]] {
+		return end + 1
+	}
+	return 0
+}
+
+// date recognizes numeric dates such as ۱۴۰۲/۰۵/۱۲ and a month name next to a
+// day or year, as in ۱۲ فروردین ۱۴۰۲
+func (m *matcher) date(i int) int {
+	tok := m.toks[i]
+	if tok.Kind == tokenize.Number && strings.Count(tok.Text, "/") == 2 {
+		return i + 1
+	}
+
+	number := func(j int) bool { return j < len(m.toks) && m.toks[j].Kind == tokenize.Number }
+	month := func(j int) bool { return j < len(m.toks) && months[m.keys[j]] }
+	switch {
+	case number(i) && month(i+1):
+		if number(i + 2) {
+			return i + 3
+		}
+		return i + 2
+	case month(i) && number(i+1):
+		return i + 2
+	}
+	return 0
+}
+
+var keyNormalizer = normalize.New(normalize.Options{
+	UnifyCharacters:  true,
+	Digits:           normalize.DigitsLatin,
+	RemoveDiacritics: true,
+	RemoveTatweel:    true,
+})
+
+// matchKey is the form tokens are compared in, ignoring letter forms,
+// half-spaces and case
+func matchKey(text string) string {
+	return strings.ToLower(strings.ReplaceAll(keyNormalizer.Normalize(text), "\u200c", ""))
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+func TestGazetteerRecognizer(t *testing.T) {
+	type entity struct {
+		text string
+		typ  pb.EntityType
+	}
+	tests := []struct {
+		name string
+		text string
+		want []entity
+	}{
+		{"gazetteer", "گوشی آیفون ۱۳ پرو از دیجی‌کالا", []entity{
+			{"آیفون ۱۳ پرو", pb.EntityType_ENTITY_TYPE_PRODUCT}, {"دیجی‌کالا", pb.EntityType_ENTITY_TYPE_ORG},
+		}},
+		{"multi-word names", "پیتزا پپرونی رو با همراه اول سفارش دادم", []entity{
+			{"پیتزا پپرونی", pb.EntityType_ENTITY_TYPE_PRODUCT}, {"همراه اول", pb.EntityType_ENTITY_TYPE_ORG},
+		}},
+		{"titles and surnames", "آقای کریمی و علی رضایی و مریم را دیدم", []entity{
+			{"کریمی", pb.EntityType_ENTITY_TYPE_PER}, {"علی رضایی", pb.EntityType_ENTITY_TYPE_PER},
+			{"مریم", pb.EntityType_ENTITY_TYPE_PER},
+		}},
+		{"first name before a verb", "علی گفتی چی شد", []entity{{"علی", pb.EntityType_ENTITY_TYPE_PER}}},
+		{"cue words", "رستوران شاندیز در خیابان ولیعصر خیلی شلوغ بود", []entity{
+			{"رستوران شاندیز", pb.EntityType_ENTITY_TYPE_ORG}, {"خیابان ولیعصر", pb.EntityType_ENTITY_TYPE_LOC},
+		}},
+		{"cue word without a name", "رستوران خیلی تمیز بود", nil},
+		{"money", "قیمت ۱۲۰,۰۰۰ تومان و پنج دلار و $20 بود", []entity{
+			{"۱۲۰,۰۰۰ تومان", pb.EntityType_ENTITY_TYPE_MONEY}, {"پنج دلار", pb.EntityType_ENTITY_TYPE_MONEY},
+			{"$20", pb.EntityType_ENTITY_TYPE_MONEY},
+		}},
+		{"dates", "۱۲ فروردین ۱۴۰۲ و ۱۴۰۲/۰۵/۱۲ و سه شنبه", []entity{
+			{"۱۲ فروردین ۱۴۰۲", pb.EntityType_ENTITY_TYPE_DATE}, {"۱۴۰۲/۰۵/۱۲", pb.EntityType_ENTITY_TYPE_DATE},
+			{"سه شنبه", pb.EntityType_ENTITY_TYPE_DATE},
+		}},
+		{"arabic letters", "شهر كرمانشاه", []entity{{"كرمانشاه", pb.EntityType_ENTITY_TYPE_LOC}}},
+	}
+
+	r := NewGazetteerRecognizer(DefaultGazetteer())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := r.Recognize(context.Background(), tt.text, "fa")
+			if err != nil {
+				t.Fatalf("Recognize() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Recognize(%q) = %v, want %d entities", tt.text, got, len(tt.want))
+			}
+			runes := []rune(tt.text)
+			for i, w := range tt.want {
+				if got[i].Text != w.text || got[i].Type != w.typ {
+					t.Errorf("entity %d = %q %v, want %q %v", i, got[i].Text, got[i].Type, w.text, w.typ)
+				}
+				if string(runes[got[i].Start:got[i].End]) != got[i].Text {
+					t.Errorf("offsets of %q select %q", got[i].Text, string(runes[got[i].Start:got[i].End]))
+				}
+			}
+		})
+	}
+}
+
+func TestGazetteerLoad(t *testing.T) {
+	g := Gazetteer{}
+	err := g.Load(strings.NewReader("# shop products\nproduct\tکوفته تبریزی\n\nORG\tقنادی ناتلی\n"))
+	if err != nil {
+		t.Fatalf("Load() error = %v", err)
+	}
+	got, _ := NewGazetteerRecognizer(g).Recognize(context.Background(), "کوفته تبریزی عالی بود", "fa")
+	if len(got) != 1 || got[0].Type != pb.EntityType_ENTITY_TYPE_PRODUCT {
+		t.Errorf("unexpected entities %v", got)
+	}
+
+	if err := g.Load(strings.NewReader("CITY\tتهران\n")); err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+}
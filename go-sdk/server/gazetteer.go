@@ -0,0 +1,123 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// Gazetteer lists known names by entity type. Names may span several words.
+type Gazetteer map[pb.EntityType][]string
+
+// Add adds names of the given type
+func (g Gazetteer) Add(typ pb.EntityType, names ...string) {
+	g[typ] = append(g[typ], names...)
+}
+
+// Load adds the names read from r. Every line holds a type, one of PER, LOC,
+// ORG, PRODUCT, DATE and MONEY, and a name separated by a tab. Blank lines and
+// lines starting with # are skipped.
+func (g Gazetteer) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		label, name, ok := strings.Cut(line, "\t")
+		typ, known := pb.EntityType_value["ENTITY_TYPE_"+strings.ToUpper(strings.TrimSpace(label))]
+		if !ok || !known || typ == 0 || strings.TrimSpace(name) == "" {
+			return fmt.Errorf("invalid gazetteer line %d: %q", n, line)
+		}
+		g.Add(pb.EntityType(typ), strings.TrimSpace(name))
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read gazetteer: %w", err)
+	}
+	return nil
+}
+
+// DefaultGazetteer returns a new gazetteer with common Iranian places,
+// companies and products, first names and relative dates
+func DefaultGazetteer() Gazetteer {
+	return Gazetteer{
+		pb.EntityType_ENTITY_TYPE_PER: {
+			"علی", "محمد", "حسین", "حسن", "رضا", "مهدی", "امیر", "سینا", "نیما", "آرش",
+			"سارا", "مریم", "زهرا", "فاطمه", "نگار", "الهام", "مینا", "پرستو", "نازنین", "شیما",
+		},
+		pb.EntityType_ENTITY_TYPE_LOC: {
+			"ایران", "تهران", "مشهد", "اصفهان", "شیراز", "تبریز", "کرج", "قم", "اهواز",
+			"کرمانشاه", "رشت", "ارومیه", "زاهدان", "همدان", "یزد", "کرمان", "اردبیل",
+			"بندرعباس", "اراک", "قزوین", "زنجان", "سنندج", "گرگان", "ساری", "کیش",
+			"ونک", "تجریش", "سعادت‌آباد", "پونک", "نارمک", "تهرانپارس",
+			"ترکیه", "دبی", "آلمان", "آمریکا", "چین", "ژاپن", "کره",
+		},
+		pb.EntityType_ENTITY_TYPE_ORG: {
+			"اسنپ‌فود", "اسنپ فود", "اسنپ", "تپسی", "دیجی‌کالا", "دیوار", "ایرانسل", "همراه اول",
+			"رایتل", "بانک ملی", "بانک ملت", "بانک سپه", "سامسونگ", "اپل", "شیائومی",
+			"هواوی", "سونی", "گوگل", "Samsung", "Apple", "Xiaomi", "Huawei", "Sony", "Google",
+		},
+		pb.EntityType_ENTITY_TYPE_PRODUCT: {
+			"آیفون", "iPhone", "گلکسی", "Galaxy", "ایرپاد", "AirPods", "آیپد", "iPad",
+			"مک‌بوک", "MacBook", "پلی‌استیشن", "PlayStation", "ایکس‌باکس", "Xbox",
+			"پیتزا پپرونی", "پیتزا مخصوص", "پیتزا مارگاریتا", "چلوکباب", "جوجه‌کباب",
+			"کباب کوبیده", "قرمه‌سبزی", "همبرگر", "چیزبرگر", "سوشی", "سالاد سزار",
+		},
+		pb.EntityType_ENTITY_TYPE_DATE: {
+			"امروز", "دیروز", "فردا", "پریروز", "پس‌فردا", "دیشب", "امشب",
+			"شنبه", "یکشنبه", "دوشنبه", "سه‌شنبه", "سه شنبه", "چهارشنبه",
+			"پنج‌شنبه", "پنج شنبه", "جمعه", "هفته پیش", "هفته گذشته", "ماه پیش", "ماه گذشته",
+		},
+	}
+}
+
+// keySet builds a set of match keys
+func keySet(words ...string) map[string]bool {
+	set := make(map[string]bool, len(words))
+	for _, w := range words {
+		set[matchKey(w)] = true
+	}
+	return set
+}
+
+// personTitles precede a name that is not part of the title
+var personTitles = keySet("آقای", "آقا", "خانم", "دکتر", "مهندس", "استاد", "جناب", "سرکار")
+
+// locationCues and organizationCues begin a name they are part of
+var (
+	locationCues     = keySet("خیابان", "میدان", "بلوار", "کوچه", "اتوبان", "بزرگراه", "محله", "شهرک", "استان")
+	organizationCues = keySet("شرکت", "بانک", "دانشگاه", "هتل", "رستوران", "فروشگاه", "کافه", "قنادی")
+)
+
+// surnameSuffixes end common Persian family names, as in رضایی or حسین‌زاده
+var surnameSuffixes = []string{"ی", "زاده", "پور", "نیا", "فر", "یان", "لو"}
+
+// stopWords cannot be part of a name following a cue word
+var stopWords = keySet(
+	"و", "یا", "از", "به", "با", "در", "بر", "تا", "را", "که", "این", "آن", "هم", "نیز",
+	"برای", "اما", "ولی", "چون", "اگر", "هر", "همه", "یک", "من", "تو", "او", "ما", "شما",
+	"است", "بود", "بودند", "هست", "نیست", "نبود", "شد", "شده", "کرد", "کرده", "دارد", "داشت",
+	"خیلی", "بسیار", "واقعا", "کاملا", "اصلا", "خوب", "بد", "عالی", "بزرگ", "کوچک", "جدید",
+	"سرد", "گرم", "تمیز", "کثیف", "شلوغ", "خلوت", "نزدیک", "دور", "اول", "آخر",
+)
+
+// modelWords may follow a product name as part of its model
+var modelWords = keySet("pro", "max", "plus", "ultra", "mini", "lite", "پرو", "مکس", "پلاس", "اولترا", "مینی", "لایت")
+
+var (
+	currencies    = keySet("تومان", "تومن", "ریال", "دلار", "یورو", "درهم", "پوند", "لیر")
+	currencySigns = keySet("$", "€", "£")
+	numberWords   = keySet(
+		"یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه", "ده", "بیست", "سی",
+		"چهل", "پنجاه", "شصت", "هفتاد", "هشتاد", "نود", "صد", "دویست", "سیصد", "پانصد",
+		"هزار", "میلیون", "میلیارد", "نیم",
+	)
+	months = keySet(
+		"فروردین", "اردیبهشت", "خرداد", "تیر", "مرداد", "شهریور", "مهر", "آبان", "آذر", "دی",
+		"بهمن", "اسفند", "ژانویه", "فوریه", "مارس", "آوریل", "مه", "ژوئن", "ژوئیه", "اوت",
+		"سپتامبر", "اکتبر", "نوامبر", "دسامبر",
+	)
+)
@@ -0,0 +1,79 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// ServiceName is the name the NLPManager service reports its health under
+var ServiceName = pb.NLPManager_ServiceDesc.ServiceName
+
+// upstreamRetryDelay is how long WatchUpstream waits before watching again
+const upstreamRetryDelay = time.Second
+
+// SetServing sets the health of the NLPManager service and of the server as a whole
+func SetServing(h *health.Server, serving bool) {
+	st := healthpb.HealthCheckResponse_NOT_SERVING
+	if serving {
+		st = healthpb.HealthCheckResponse_SERVING
+	}
+	h.SetServingStatus("", st)
+	h.SetServingStatus(ServiceName, st)
+}
+
+// WatchUpstream reports whether the NLPManager service of an upstream engine is
+// serving to onChange until ctx is done. Engines without a health service are
+// considered serving while the connection to them is ready.
+func WatchUpstream(ctx context.Context, conn *grpc.ClientConn, onChange func(serving bool)) {
+	client := healthpb.NewHealthClient(conn)
+	for ctx.Err() == nil {
+		err := watchHealth(ctx, client, onChange)
+		if status.Code(err) == codes.Unimplemented {
+			watchConnectivity(ctx, conn, onChange)
+			return
+		}
+		onChange(false)
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(upstreamRetryDelay):
+		}
+	}
+}
+
+// watchHealth follows the upstream health until the watch fails
+func watchHealth(ctx context.Context, client healthpb.HealthClient, onChange func(bool)) error {
+	stream, err := client.Watch(ctx, &healthpb.HealthCheckRequest{Service: ServiceName})
+	if err != nil {
+		return err
+	}
+	for {
+		resp, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+		onChange(resp.Status == healthpb.HealthCheckResponse_SERVING)
+	}
+}
+
+// watchConnectivity follows the state of the connection until ctx is done
+func watchConnectivity(ctx context.Context, conn *grpc.ClientConn, onChange func(bool)) {
+	for {
+		state := conn.GetState()
+		onChange(state == connectivity.Ready)
+		if state == connectivity.Idle {
+			conn.Connect()
+		}
+		if !conn.WaitForStateChange(ctx, state) {
+			return
+		}
+	}
+}
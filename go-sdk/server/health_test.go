@@ -0,0 +1,66 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+)
+
+func TestHealthSkipsAuthentication(t *testing.T) {
+	dial := startServer(t, Options{BearerTokens: []string{"s3cret"}})
+
+	if err := newClient(t, go_sdk.Config{Insecure: true}, dial).Health(context.Background()); err != nil {
+		t.Fatalf("Health() error = %v", err)
+	}
+}
+
+func TestWatchUpstream(t *testing.T) {
+	upstreamHealth := health.NewServer()
+	SetServing(upstreamHealth, false)
+	conn, err := grpc.NewClient("passthrough:///bufnet", startServer(t, Options{Health: upstreamHealth}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	var mu sync.Mutex
+	var statuses []bool
+	changed := make(chan struct{}, 16)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go WatchUpstream(ctx, conn, func(serving bool) {
+		mu.Lock()
+		statuses = append(statuses, serving)
+		mu.Unlock()
+		changed <- struct{}{}
+	})
+
+	wait := func(want bool) {
+		t.Helper()
+		timeout := time.After(5 * time.Second)
+		for {
+			mu.Lock()
+			got := len(statuses) > 0 && statuses[len(statuses)-1] == want
+			mu.Unlock()
+			if got {
+				return
+			}
+			select {
+			case <-changed:
+			case <-timeout:
+				t.Fatalf("upstream never reported serving = %v", want)
+			}
+		}
+	}
+
+	wait(false)
+	SetServing(upstreamHealth, true)
+	wait(true)
+}
@@ -0,0 +1,256 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// Scorer assigns a sentiment to a single text
+type Scorer interface {
+	Score(ctx context.Context, text, lang string) (*pb.SentimentResponse, error)
+}
+
+// positiveWords and negativeWords map Persian opinion words to their strength
+var positiveWords = map[string]float64{
+	"عالی": 2, "عالیه": 2, "محشر": 2, "فوق‌العاده": 2, "بی‌نظیر": 2, "بهترین": 2,
+	"خوب": 1, "خوبه": 1, "خوبی": 1, "بهتر": 1, "راضی": 1.5, "رضایت": 1.5,
+	"خوشمزه": 1.5, "لذیذ": 1.5, "دلچسب": 1.5, "تازه": 1, "داغ": 0.5, "گرم": 0.5,
+	"سریع": 1, "به‌موقع": 1, "مناسب": 1, "مناسبی": 1, "ارزان": 1, "ارزون": 1,
+	"تمیز": 1, "زیبا": 1, "قشنگ": 1, "شیک": 1, "سالم": 1, "دوست": 1, "عاشق": 2,
+	"ممنون": 1, "مرسی": 1, "تشکر": 1, "خوشحال": 1.5, "توصیه": 1, "پیشنهاد": 1,
+	"ارزش": 1, "باکیفیت": 1.5, "حرفه‌ای": 1, "مودب": 1, "خوش": 1,
+}
+
+var negativeWords = map[string]float64{
+	"افتضاح": 2, "مزخرف": 2, "بدترین": 2, "آشغال": 2, "وحشتناک": 2, "فاجعه": 2,
+	"بد": 1.5, "بده": 1.5, "بدی": 1.5, "بدتر": 1.5, "ضعیف": 1, "ناراضی": 1.5,
+	"ناامید": 1.5, "نارضایتی": 1.5, "پشیمان": 1.5, "ناراحت": 1, "متاسفانه": 1,
+	"سرد": 1, "دیر": 1, "تاخیر": 1, "گران": 1, "گرون": 1, "کثیف": 1.5,
+	"خراب": 1.5, "شکسته": 1.5, "معیوب": 1.5, "کهنه": 1, "بی‌کیفیت": 1.5,
+	"بی‌مزه": 1, "شور": 0.5, "زشت": 1, "ناخوشایند": 1, "مشکل": 1, "اشتباه": 1,
+	"بی‌ادب": 1.5, "بی‌کیفیتی": 1.5,
+}
+
+// negators flip the polarity of the opinion words just before them, as Persian
+// is verb-final ("خوب نیست"), or of the word just after "نه"
+var negators = map[string]bool{
+	"نه": true, "نیست": true, "نبود": true, "نیستم": true, "نیستند": true,
+	"ندارد": true, "نداره": true, "نداشت": true, "نداشتم": true, "هرگز": true,
+	"هیچ": true, "نخرید": true, "نکنید": true, "نکردم": true, "نشد": true,
+}
+
+// intensifiers strengthen the next opinion word
+var intensifiers = map[string]float64{
+	"خیلی": 1.5, "بسیار": 1.5, "واقعا": 1.5, "واقعاً": 1.5, "کاملا": 1.5,
+	"کاملاً": 1.5, "اصلا": 1.5, "اصلاً": 1.5, "فوق": 1.5, "شدیدا": 1.5,
+}
+
+// clauseBreaks end the scope of negators and intensifiers
+var clauseBreaks = map[string]bool{
+	"و": true, "ولی": true, "اما": true, "ولیکن": true, "چون": true, "که": true,
+}
+
+// negationWindow is how many tokens before a negator it can reach
+const negationWindow = 3
+
+// mixedRatio is how close positive and negative evidence must be to call a text mixed
+const mixedRatio = 0.25
+
+// LexiconModelVersion is the model version reported by the LexiconScorer.
+// Bump it whenever the word lists or the scoring change.
+const LexiconModelVersion = "lexicon-1"
+
+// LexiconScorer is a dependency-free Persian sentiment scorer based on word lists,
+// negation and intensifiers. It is meant for development, CI and as a fallback
+// when the ParsBERT engine is not available.
+type LexiconScorer struct{}
+
+// NewLexiconScorer creates a lexicon based scorer
+func NewLexiconScorer() *LexiconScorer {
+	return &LexiconScorer{}
+}
+
+type opinion struct {
+	index  int
+	weight float64
+}
+
+// Score implements Scorer
+func (l *LexiconScorer) Score(ctx context.Context, text, lang string) (*pb.SentimentResponse, error) {
+	var pos, neg float64
+	for _, clause := range splitClauses(splitWords(text)) {
+		p, n := scoreClause(clause)
+		pos += p
+		neg += n
+	}
+	return distribution(pos, neg), nil
+}
+
+// scoreClause sums positive and negative evidence in a clause
+func scoreClause(tokens []string) (pos, neg float64) {
+	var opinions []opinion
+	boost := 1.0
+
+	for i, tok := range tokens {
+		if f, ok := intensifiers[tok]; ok {
+			boost *= f
+			continue
+		}
+		if w, ok := lookup(tok); ok {
+			opinions = append(opinions, opinion{index: i, weight: w * boost})
+			boost = 1
+		}
+	}
+
+	for i, tok := range tokens {
+		if !isNegator(tok) {
+			continue
+		}
+		if tok == "نه" {
+			for j := range opinions {
+				if opinions[j].index == i+1 {
+					opinions[j].weight = -opinions[j].weight
+				}
+			}
+			continue
+		}
+		for j := range opinions {
+			if opinions[j].index < i && opinions[j].index >= i-negationWindow {
+				opinions[j].weight = -opinions[j].weight
+			}
+		}
+	}
+
+	for _, o := range opinions {
+		if o.weight > 0 {
+			pos += o.weight
+		} else {
+			neg -= o.weight
+		}
+	}
+	return pos, neg
+}
+
+// distribution turns evidence into a labelled probability distribution.
+// Neutral acts as a constant prior so that weak evidence stays uncertain.
+func distribution(pos, neg float64) *pb.SentimentResponse {
+	const neutralPrior = 0.5
+	total := pos + neg + neutralPrior
+	probs := map[string]float64{
+		"positive": pos / total,
+		"negative": neg / total,
+		"neutral":  neutralPrior / total,
+	}
+
+	resp := &pb.SentimentResponse{Probabilities: probs, ModelVersion: LexiconModelVersion}
+	switch {
+	case pos > 0 && neg > 0 && abs(pos-neg) <= mixedRatio*(pos+neg):
+		resp.Label, resp.Sentiment, resp.Score = "mixed", pb.SentimentLabel_SENTIMENT_LABEL_MIXED, probs["positive"]+probs["negative"]
+	case probs["positive"] > probs["negative"] && probs["positive"] > probs["neutral"]:
+		resp.Label, resp.Sentiment, resp.Score = "positive", pb.SentimentLabel_SENTIMENT_LABEL_POSITIVE, probs["positive"]
+	case probs["negative"] > probs["positive"] && probs["negative"] > probs["neutral"]:
+		resp.Label, resp.Sentiment, resp.Score = "negative", pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE, probs["negative"]
+	default:
+		resp.Label, resp.Sentiment, resp.Score = "neutral", pb.SentimentLabel_SENTIMENT_LABEL_NEUTRAL, probs["neutral"]
+	}
+	return resp
+}
+
+// lookup returns the signed weight of an opinion word, trying a few common suffixes
+func lookup(tok string) (float64, bool) {
+	for _, cand := range candidates(tok) {
+		if w, ok := positiveWords[cand]; ok {
+			return w, true
+		}
+		if w, ok := negativeWords[cand]; ok {
+			return -w, true
+		}
+	}
+	return 0, false
+}
+
+var suffixes = []string{"‌ترین", "ترین", "‌تر", "تر", "‌ها", "ها", "‌ای", "ی", "ه"}
+
+func candidates(tok string) []string {
+	out := []string{tok}
+	for _, s := range suffixes {
+		if stem := strings.TrimSuffix(tok, s); stem != tok && stem != "" {
+			out = append(out, strings.TrimSuffix(stem, "‌"))
+		}
+	}
+	return out
+}
+
+func isNegator(tok string) bool {
+	if negators[tok] {
+		return true
+	}
+	// Negated present continuous verbs: نمی‌کنم, نمیخرم, ...
+	return strings.HasPrefix(tok, "نمی") || strings.HasPrefix(tok, "نمي")
+}
+
+func splitClauses(tokens []string) [][]string {
+	var clauses [][]string
+	var cur []string
+	for _, tok := range tokens {
+		if clauseBreaks[tok] || tok == "." || tok == "،" || tok == "!" || tok == "؟" || tok == "?" || tok == "," || tok == "؛" {
+			if len(cur) > 0 {
+				clauses = append(clauses, cur)
+			}
+			cur = nil
+			continue
+		}
+		cur = append(cur, tok)
+	}
+	if len(cur) > 0 {
+		clauses = append(clauses, cur)
+	}
+	return clauses
+}
+
+// splitWords splits text into words and punctuation marks after unifying Arabic
+// code points with their Persian forms
+func splitWords(text string) []string {
+	var tokens []string
+	var cur strings.Builder
+	flush := func() {
+		if cur.Len() > 0 {
+			tokens = append(tokens, cur.String())
+			cur.Reset()
+		}
+	}
+
+	for _, r := range text {
+		switch r {
+		case 'ي', 'ى':
+			r = 'ی'
+		case 'ك':
+			r = 'ک'
+		case 'ـ':
+			continue
+		}
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// Drop diacritics
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '‌':
+			cur.WriteRune(r)
+		case unicode.IsSpace(r):
+			flush()
+		default:
+			flush()
+			tokens = append(tokens, string(r))
+		}
+	}
+	flush()
+	return tokens
+}
+
+func abs(x float64) float64 {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,166 @@
+package server
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/internal/telemetry"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+// Metrics collects Prometheus metrics about the calls a server handles
+type Metrics struct {
+	registry *prometheus.Registry
+
+	requests    *prometheus.CounterVec
+	labels      *prometheus.CounterVec
+	duration    *prometheus.HistogramVec
+	inFlight    *prometheus.GaugeVec
+	textLength  *prometheus.HistogramVec
+	modelLoaded prometheus.Gauge
+}
+
+// NewMetrics creates the metrics in a new registry that also reports Go
+// runtime and process metrics
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zennlp_requests_total",
+			Help: "NLPManager calls by RPC and status code.",
+		}, []string{"rpc", "code"}),
+		labels: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "zennlp_labels_total",
+			Help: "Analyzed texts by RPC and sentiment label.",
+		}, []string{"rpc", "label"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "zennlp_request_duration_seconds",
+			Help:    "Duration of NLPManager calls.",
+			Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		}, []string{"rpc"}),
+		inFlight: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "zennlp_in_flight_requests",
+			Help: "NLPManager calls currently being handled.",
+		}, []string{"rpc"}),
+		textLength: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "zennlp_text_length_runes",
+			Help:    "Length of analyzed texts in characters.",
+			Buckets: prometheus.ExponentialBuckets(8, 2, 9),
+		}, []string{"rpc"}),
+		modelLoaded: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "zennlp_model_loaded",
+			Help: "Whether the sentiment model is loaded and serving (1) or not (0).",
+		}),
+	}
+
+	m.registry.MustRegister(
+		m.requests, m.labels, m.duration, m.inFlight, m.textLength, m.modelLoaded,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+// SetModelLoaded records whether the model behind the server is ready
+func (m *Metrics) SetModelLoaded(loaded bool) {
+	if loaded {
+		m.modelLoaded.Set(1)
+	} else {
+		m.modelLoaded.Set(0)
+	}
+}
+
+// Registry returns the registry the metrics are registered in, to add more
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler serves the metrics in the Prometheus exposition format
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// UnaryInterceptor records metrics for unary NLPManager calls
+func (m *Metrics) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		service, rpc := telemetry.SplitMethod(info.FullMethod)
+		if service != ServiceName {
+			return handler(ctx, req)
+		}
+		done := m.start(rpc)
+		m.observeRequest(rpc, req)
+
+		resp, err := handler(ctx, req)
+		done(err)
+		if err == nil {
+			m.observeResponse(rpc, resp)
+		}
+		return resp, err
+	}
+}
+
+// StreamInterceptor records metrics for streaming NLPManager calls and every message on them
+func (m *Metrics) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		service, rpc := telemetry.SplitMethod(info.FullMethod)
+		if service != ServiceName {
+			return handler(srv, ss)
+		}
+		done := m.start(rpc)
+
+		err := handler(srv, &metricsStream{ServerStream: ss, metrics: m, rpc: rpc})
+		done(err)
+		return err
+	}
+}
+
+// start counts a call as in flight and returns a func recording its outcome
+func (m *Metrics) start(rpc string) func(error) {
+	began := time.Now()
+	m.inFlight.WithLabelValues(rpc).Inc()
+	return func(err error) {
+		m.inFlight.WithLabelValues(rpc).Dec()
+		m.duration.WithLabelValues(rpc).Observe(time.Since(began).Seconds())
+		m.requests.WithLabelValues(rpc, status.Code(err).String()).Inc()
+	}
+}
+
+func (m *Metrics) observeRequest(rpc string, req any) {
+	for _, n := range telemetry.TextLengths(req) {
+		m.textLength.WithLabelValues(rpc).Observe(float64(n))
+	}
+}
+
+func (m *Metrics) observeResponse(rpc string, resp any) {
+	for _, label := range telemetry.ResponseLabels(resp) {
+		m.labels.WithLabelValues(rpc, label).Inc()
+	}
+}
+
+// metricsStream observes the messages of a streaming call
+type metricsStream struct {
+	grpc.ServerStream
+	metrics *Metrics
+	rpc     string
+}
+
+func (s *metricsStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.metrics.observeRequest(s.rpc, m)
+	}
+	return err
+}
+
+func (s *metricsStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.metrics.observeResponse(s.rpc, m)
+	}
+	return err
+}
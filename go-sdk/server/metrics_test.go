@@ -0,0 +1,174 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestMetricsRecordCalls(t *testing.T) {
+	metrics := NewMetrics()
+	metrics.SetModelLoaded(true)
+	client := newClient(t, go_sdk.Config{Insecure: true}, startServer(t, Options{Metrics: metrics}))
+	ctx := context.Background()
+
+	if _, err := client.Analyze(ctx, "عالی بود"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if _, err := client.Analyze(ctx, ""); err == nil {
+		t.Fatal("expected an error for empty text")
+	}
+	if _, err := client.AnalyzeBatch(ctx, []string{"خوب", "بد"}); err != nil {
+		t.Fatalf("AnalyzeBatch() error = %v", err)
+	}
+
+	for _, c := range []struct {
+		rpc, code string
+		want      float64
+	}{
+		{"AnalyzeSentiment", "OK", 1},
+		{"AnalyzeSentiment", "InvalidArgument", 1},
+		{"AnalyzeSentimentBatch", "OK", 1},
+	} {
+		if got := testutil.ToFloat64(metrics.requests.WithLabelValues(c.rpc, c.code)); got != c.want {
+			t.Errorf("requests{%s,%s} = %v, want %v", c.rpc, c.code, got, c.want)
+		}
+	}
+	if got := testutil.ToFloat64(metrics.labels.WithLabelValues("AnalyzeSentimentBatch", "negative")); got != 1 {
+		t.Errorf("expected one negative batch item, got %v", got)
+	}
+	if got := testutil.CollectAndCount(metrics.textLength); got != 2 {
+		t.Errorf("expected text length series for 2 RPCs, got %d", got)
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	for _, line := range []string{
+		"zennlp_model_loaded 1",
+		`zennlp_in_flight_requests{rpc="AnalyzeSentiment"} 0`,
+		`zennlp_request_duration_seconds_count{rpc="AnalyzeSentimentBatch"} 1`,
+	} {
+		if !strings.Contains(rec.Body.String(), line) {
+			t.Errorf("/metrics is missing %q", line)
+		}
+	}
+}
+
+func TestProxyForwardsCalls(t *testing.T) {
+	conn, err := grpc.NewClient("passthrough:///bufnet", startServer(t, Options{}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	metrics := NewMetrics()
+	proxy := NewProxy(pb.NewNLPManagerClient(conn))
+	client := newClient(t, go_sdk.Config{Insecure: true}, startService(t, proxy, Options{Metrics: metrics}))
+	ctx := context.Background()
+
+	result, err := client.Analyze(ctx, "عالی بود")
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+	if !result.IsPositive() {
+		t.Errorf("expected positive result, got %s", result.Sentiment)
+	}
+
+	stream, err := client.Stream(ctx)
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	defer stream.Close()
+	for _, id := range []string{"1", "2"} {
+		if err := stream.Send(id, "بد بود"); err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	}
+	stream.CloseSend()
+	n := 0
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if res.Err != nil || !res.Result.IsNegative() {
+			t.Errorf("unexpected result for %s: %v %v", res.ID, res.Result, res.Err)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Errorf("expected 2 stream results, got %d", n)
+	}
+	if got := testutil.ToFloat64(metrics.labels.WithLabelValues("StreamSentiment", "negative")); got != 2 {
+		t.Errorf("expected 2 negative stream labels, got %v", got)
+	}
+}
+
+// engineStub is an upstream implementing none of the methods, like an engine
+// older than the proxy
+type engineStub struct {
+	pb.UnimplementedNLPManagerServer
+}
+
+func (e engineStub) Register(r grpc.ServiceRegistrar) {
+	pb.RegisterNLPManagerServer(r, e)
+}
+
+func TestProxyFallsBackForUnimplementedCalls(t *testing.T) {
+	conn, err := grpc.NewClient("passthrough:///bufnet", startService(t, engineStub{}, Options{}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	proxy := NewProxy(pb.NewNLPManagerClient(conn))
+	client := newClient(t, go_sdk.Config{Insecure: true}, startService(t, proxy, Options{}))
+
+	lemmas, err := client.Lemmatize(context.Background(), "کتاب‌ها")
+	if err != nil {
+		t.Fatalf("Lemmatize() error = %v", err)
+	}
+	if len(lemmas) != 1 || lemmas[0].Lemma != "کتاب" {
+		t.Errorf("unexpected lemmas %v", lemmas)
+	}
+	sentences, err := client.Sentences(context.Background(), "خوب بود. ممنون")
+	if err != nil {
+		t.Fatalf("Sentences() error = %v", err)
+	}
+	if len(sentences) != 2 {
+		t.Errorf("expected 2 sentences, got %v", sentences)
+	}
+	tokens, err := client.TagPartsOfSpeech(context.Background(), "غذا سرد بود")
+	if err != nil {
+		t.Fatalf("TagPartsOfSpeech() error = %v", err)
+	}
+	if len(tokens) != 3 || tokens[1].Tag != go_sdk.POSAdjective {
+		t.Errorf("unexpected tagged tokens %v", tokens)
+	}
+	aspects, err := client.AnalyzeAspects(context.Background(), "غذا عالی بود")
+	if err != nil {
+		t.Fatalf("AnalyzeAspects() error = %v", err)
+	}
+	if len(aspects) != 1 || aspects[0].Category != "food" {
+		t.Errorf("unexpected aspects %v", aspects)
+	}
+	if _, err := client.Analyze(context.Background(), "عالی بود"); status.Code(err) != codes.Unimplemented {
+		t.Errorf("expected sentiment analysis to stay with the engine, got %v", err)
+	}
+}
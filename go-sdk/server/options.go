@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/internal/telemetry"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+// Options configures the gRPC server built by NewGRPCServer
+type Options struct {
+	// CertFile and KeyFile are the PEM server certificate and key. Without
+	// them the server listens in plain text.
+	CertFile string
+	KeyFile  string
+	// ClientCAFile is a PEM bundle of CAs for client certificates. When set,
+	// clients must present a certificate signed by one of them (mutual TLS).
+	ClientCAFile string
+
+	// BearerTokens and APIKeys are the credentials accepted from clients, sent as
+	// "authorization: Bearer <token>" or "x-api-key". When both are empty,
+	// calls are not authenticated.
+	BearerTokens []string
+	APIKeys      []string
+
+	// TracerProvider and MeterProvider record a span and metrics for every call.
+	// They default to no-op providers.
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+
+	// Health reports the serving status over grpc.health.v1. When nil, the
+	// server reports NLPManager as serving from the start.
+	Health *health.Server
+
+	// Metrics, when set, records Prometheus metrics for every call. Serve
+	// Metrics.Handler on an HTTP port to expose them.
+	Metrics *Metrics
+
+	// KeepaliveMinTime is the shortest interval between client keepalive pings
+	// the server accepts, also while no call is open. Clients pinging more
+	// often are disconnected. Defaults to gRPC's 5 minutes.
+	KeepaliveMinTime time.Duration
+
+	// ServerOptions are appended to the options the server is created with.
+	ServerOptions []grpc.ServerOption
+}
+
+// Service is an NLPManager implementation that can register itself, such as
+// Server or Proxy
+type Service interface {
+	Register(grpc.ServiceRegistrar)
+}
+
+// NewGRPCServer creates a gRPC server with the NLPManager service registered
+func NewGRPCServer(srv Service, opts Options) (*grpc.Server, error) {
+	var serverOpts []grpc.ServerOption
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		tlsCfg, err := opts.tlsConfig()
+		if err != nil {
+			return nil, err
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsCfg)))
+	} else if opts.ClientCAFile != "" {
+		return nil, fmt.Errorf("invalid options: ClientCAFile requires CertFile and KeyFile")
+	}
+
+	instruments, err := telemetry.New("server", opts.TracerProvider, opts.MeterProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create telemetry instruments: %w", err)
+	}
+	serverOpts = append(serverOpts,
+		grpc.ChainUnaryInterceptor(instruments.UnaryServerInterceptor()),
+		grpc.ChainStreamInterceptor(instruments.StreamServerInterceptor()),
+	)
+
+	if opts.Metrics != nil {
+		serverOpts = append(serverOpts,
+			grpc.ChainUnaryInterceptor(opts.Metrics.UnaryInterceptor()),
+			grpc.ChainStreamInterceptor(opts.Metrics.StreamInterceptor()),
+		)
+	}
+
+	if len(opts.BearerTokens) > 0 || len(opts.APIKeys) > 0 {
+		auth := newAuthenticator(opts.BearerTokens, opts.APIKeys)
+		serverOpts = append(serverOpts,
+			grpc.ChainUnaryInterceptor(auth.unary),
+			grpc.ChainStreamInterceptor(auth.stream),
+		)
+	}
+
+	if opts.KeepaliveMinTime > 0 {
+		serverOpts = append(serverOpts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             opts.KeepaliveMinTime,
+			PermitWithoutStream: true,
+		}))
+	}
+
+	serverOpts = append(serverOpts, opts.ServerOptions...)
+
+	healthServer := opts.Health
+	if healthServer == nil {
+		healthServer = health.NewServer()
+		SetServing(healthServer, true)
+	}
+
+	grpcServer := grpc.NewServer(serverOpts...)
+	srv.Register(grpcServer)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+	return grpcServer, nil
+}
+
+func (opts Options) tlsConfig() (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	tlsCfg := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	if opts.ClientCAFile != "" {
+		pem, err := os.ReadFile(opts.ClientCAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read client CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("failed to parse client CA file %s: no certificates found", opts.ClientCAFile)
+		}
+		tlsCfg.ClientCAs = pool
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+
+	return tlsCfg, nil
+}
+
+// authenticator verifies the per-RPC credentials of incoming calls
+type authenticator struct {
+	tokens  [][]byte
+	apiKeys [][]byte
+}
+
+func newAuthenticator(tokens, apiKeys []string) *authenticator {
+	a := &authenticator{}
+	for _, t := range tokens {
+		a.tokens = append(a.tokens, []byte(t))
+	}
+	for _, k := range apiKeys {
+		a.apiKeys = append(a.apiKeys, []byte(k))
+	}
+	return a
+}
+
+func (a *authenticator) check(ctx context.Context) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	for _, v := range md.Get("authorization") {
+		token, ok := strings.CutPrefix(v, "Bearer ")
+		if ok && matchAny(a.tokens, token) {
+			return nil
+		}
+	}
+	for _, v := range md.Get("x-api-key") {
+		if matchAny(a.apiKeys, v) {
+			return nil
+		}
+	}
+
+	if len(md.Get("authorization")) == 0 && len(md.Get("x-api-key")) == 0 {
+		return status.Error(codes.Unauthenticated, "missing credentials")
+	}
+	return status.Error(codes.Unauthenticated, "invalid credentials")
+}
+
+func (a *authenticator) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if isHealthCheck(info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := a.check(ctx); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if isHealthCheck(info.FullMethod) {
+		return handler(srv, ss)
+	}
+	if err := a.check(ss.Context()); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
+
+// isHealthCheck reports whether method belongs to the health service, which
+// load balancers and orchestrators call without credentials
+func isHealthCheck(method string) bool {
+	return strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/")
+}
+
+// matchAny compares in constant time to avoid leaking secrets through timing
+func matchAny(secrets [][]byte, candidate string) bool {
+	found := false
+	for _, s := range secrets {
+		if subtle.ConstantTimeCompare(s, []byte(candidate)) == 1 {
+			found = true
+		}
+	}
+	return found
+}
@@ -0,0 +1,196 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestMutualTLSWithBearerToken(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	ca.issue(t, dir, "server", "localhost", false)
+	ca.issue(t, dir, "client", "sdk-client", true)
+	ca.write(t, dir)
+
+	dial := startServer(t, Options{
+		CertFile:     filepath.Join(dir, "server.pem"),
+		KeyFile:      filepath.Join(dir, "server-key.pem"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+		BearerTokens: []string{"s3cret"},
+	})
+
+	tlsConfig := go_sdk.Config{
+		CAFile:     filepath.Join(dir, "ca.pem"),
+		CertFile:   filepath.Join(dir, "client.pem"),
+		KeyFile:    filepath.Join(dir, "client-key.pem"),
+		ServerName: "localhost",
+	}
+
+	t.Run("authorized", func(t *testing.T) {
+		cfg := tlsConfig
+		cfg.BearerToken = "s3cret"
+		if _, err := newClient(t, cfg, dial).Analyze(context.Background(), "عالی بود"); err != nil {
+			t.Fatalf("Analyze() error = %v", err)
+		}
+	})
+
+	t.Run("wrong token", func(t *testing.T) {
+		cfg := tlsConfig
+		cfg.BearerToken = "guess"
+		_, err := newClient(t, cfg, dial).Analyze(context.Background(), "عالی بود")
+		if status.Code(err) != codes.Unauthenticated {
+			t.Fatalf("expected Unauthenticated, got %v", err)
+		}
+	})
+
+	t.Run("no client certificate", func(t *testing.T) {
+		cfg := tlsConfig
+		cfg.CertFile, cfg.KeyFile = "", ""
+		cfg.BearerToken = "s3cret"
+		if _, err := newClient(t, cfg, dial).Analyze(context.Background(), "عالی بود"); err == nil {
+			t.Fatal("expected the handshake to fail without a client certificate")
+		}
+	})
+}
+
+func TestAPIKeyOverInsecureConnection(t *testing.T) {
+	dial := startServer(t, Options{APIKeys: []string{"key-1"}})
+
+	if _, err := newClient(t, go_sdk.Config{Insecure: true, APIKey: "key-1"}, dial).Analyze(context.Background(), "خوب"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	_, err := newClient(t, go_sdk.Config{Insecure: true}, dial).Analyze(context.Background(), "خوب")
+	if status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expected Unauthenticated without credentials, got %v", err)
+	}
+}
+
+func startServer(t *testing.T, opts Options) grpc.DialOption {
+	t.Helper()
+	return startService(t, New(), opts)
+}
+
+func startService(t *testing.T, srv Service, opts Options) grpc.DialOption {
+	t.Helper()
+
+	grpcServer, err := NewGRPCServer(srv, opts)
+	if err != nil {
+		t.Fatalf("NewGRPCServer() error = %v", err)
+	}
+	lis := bufconn.Listen(1 << 20)
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+}
+
+func newClient(t *testing.T, cfg go_sdk.Config, dial grpc.DialOption) *go_sdk.Client {
+	t.Helper()
+
+	cfg.Address = "passthrough:///bufnet"
+	cfg.Timeout = 5 * time.Second
+	cfg.DialOptions = append(cfg.DialOptions, dial)
+	client, err := go_sdk.NewClientWithConfig(cfg)
+	if err != nil {
+		t.Fatalf("NewClientWithConfig() error = %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	der  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "zennlp test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testCA{cert: cert, key: key, der: der}
+}
+
+func (ca *testCA) issue(t *testing.T, dir, name, host string, client bool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage := x509.ExtKeyUsageServerAuth
+	if client {
+		usage = x509.ExtKeyUsageClientAuth
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writePEM(t, filepath.Join(dir, name+".pem"), "CERTIFICATE", der)
+	writePEM(t, filepath.Join(dir, name+"-key.pem"), "EC PRIVATE KEY", keyDER)
+}
+
+func (ca *testCA) write(t *testing.T, dir string) {
+	writePEM(t, filepath.Join(dir, "ca.pem"), "CERTIFICATE", ca.der)
+}
+
+func writePEM(t *testing.T, path, typ string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
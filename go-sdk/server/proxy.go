@@ -0,0 +1,162 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Proxy implements pb.NLPManagerServer by forwarding every call to an upstream
+// server, such as the Python engine. Served through NewGRPCServer it adds TLS,
+// authentication and metrics in front of an engine that has none. Calls the
+// upstream does not implement are answered by the Go implementation.
+type Proxy struct {
+	pb.UnimplementedNLPManagerServer
+
+	upstream pb.NLPManagerClient
+	fallback *Server
+}
+
+// NewProxy creates a proxy forwarding to upstream
+func NewProxy(upstream pb.NLPManagerClient) *Proxy {
+	return &Proxy{upstream: upstream, fallback: New()}
+}
+
+// WithRecognizer sets the recognizer used when the upstream cannot extract entities
+func (p *Proxy) WithRecognizer(r Recognizer) *Proxy {
+	p.fallback.WithRecognizer(r)
+	return p
+}
+
+// WithTagger sets the tagger used when the upstream cannot tag parts of speech
+func (p *Proxy) WithTagger(t Tagger) *Proxy {
+	p.fallback.WithTagger(t)
+	return p
+}
+
+// WithAspectAnalyzer sets the analyzer used when the upstream cannot analyze aspects
+func (p *Proxy) WithAspectAnalyzer(a AspectAnalyzer) *Proxy {
+	p.fallback.WithAspectAnalyzer(a)
+	return p
+}
+
+// Register registers the NLPManager service on a gRPC server
+func (p *Proxy) Register(r grpc.ServiceRegistrar) {
+	pb.RegisterNLPManagerServer(r, p)
+}
+
+// AnalyzeSentiment forwards to the upstream server
+func (p *Proxy) AnalyzeSentiment(ctx context.Context, req *pb.SentimentRequest) (*pb.SentimentResponse, error) {
+	return p.upstream.AnalyzeSentiment(ctx, req)
+}
+
+// AnalyzeSentimentBatch forwards to the upstream server
+func (p *Proxy) AnalyzeSentimentBatch(ctx context.Context, req *pb.SentimentBatchRequest) (*pb.SentimentBatchResponse, error) {
+	return p.upstream.AnalyzeSentimentBatch(ctx, req)
+}
+
+// Lemmatize forwards to the upstream server
+func (p *Proxy) Lemmatize(ctx context.Context, req *pb.LemmatizeRequest) (*pb.LemmatizeResponse, error) {
+	resp, err := p.upstream.Lemmatize(ctx, req)
+	if unimplemented(err) {
+		return p.fallback.Lemmatize(ctx, req)
+	}
+	return resp, err
+}
+
+// Tokenize forwards to the upstream server
+func (p *Proxy) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	resp, err := p.upstream.Tokenize(ctx, req)
+	if unimplemented(err) {
+		return p.fallback.Tokenize(ctx, req)
+	}
+	return resp, err
+}
+
+// SegmentSentences forwards to the upstream server
+func (p *Proxy) SegmentSentences(ctx context.Context, req *pb.SegmentSentencesRequest) (*pb.SegmentSentencesResponse, error) {
+	resp, err := p.upstream.SegmentSentences(ctx, req)
+	if unimplemented(err) {
+		return p.fallback.SegmentSentences(ctx, req)
+	}
+	return resp, err
+}
+
+// ExtractEntities forwards to the upstream server
+func (p *Proxy) ExtractEntities(ctx context.Context, req *pb.ExtractEntitiesRequest) (*pb.ExtractEntitiesResponse, error) {
+	resp, err := p.upstream.ExtractEntities(ctx, req)
+	if unimplemented(err) {
+		return p.fallback.ExtractEntities(ctx, req)
+	}
+	return resp, err
+}
+
+// TagPartsOfSpeech forwards to the upstream server
+func (p *Proxy) TagPartsOfSpeech(ctx context.Context, req *pb.TagPartsOfSpeechRequest) (*pb.TagPartsOfSpeechResponse, error) {
+	resp, err := p.upstream.TagPartsOfSpeech(ctx, req)
+	if unimplemented(err) {
+		return p.fallback.TagPartsOfSpeech(ctx, req)
+	}
+	return resp, err
+}
+
+// AnalyzeAspects forwards to the upstream server
+func (p *Proxy) AnalyzeAspects(ctx context.Context, req *pb.AnalyzeAspectsRequest) (*pb.AnalyzeAspectsResponse, error) {
+	resp, err := p.upstream.AnalyzeAspects(ctx, req)
+	if unimplemented(err) {
+		return p.fallback.AnalyzeAspects(ctx, req)
+	}
+	return resp, err
+}
+
+// unimplemented reports whether the upstream does not know the called method,
+// e.g. a Python engine predating it
+func unimplemented(err error) bool {
+	return status.Code(err) == codes.Unimplemented
+}
+
+// StreamSentiment relays messages in both directions until either side ends the stream
+func (p *Proxy) StreamSentiment(stream pb.NLPManager_StreamSentimentServer) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	upstream, err := p.upstream.StreamSentiment(ctx)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					upstream.CloseSend()
+				} else {
+					cancel()
+				}
+				return
+			}
+			if err := upstream.Send(req); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		resp, err := upstream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// Package server implements the NLPManager gRPC service in pure Go, so ZenNLP
+// can run without the Python engine during development and in CI.
+package server
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server implements pb.NLPManagerServer
+type Server struct {
+	pb.UnimplementedNLPManagerServer
+
+	scorer     Scorer
+	recognizer Recognizer
+	tagger     Tagger
+	aspects    AspectAnalyzer
+}
+
+// New creates a server backed by the Persian lexicon scorer
+func New() *Server {
+	return NewWithScorer(NewLexiconScorer())
+}
+
+// NewWithScorer creates a server backed by the given scorer
+func NewWithScorer(scorer Scorer) *Server {
+	return &Server{
+		scorer:     scorer,
+		recognizer: NewGazetteerRecognizer(DefaultGazetteer()),
+		tagger:     NewHMMTagger(DefaultTagLexicon()),
+		aspects:    NewLexiconAspectAnalyzer(DefaultTaxonomy()),
+	}
+}
+
+// WithRecognizer replaces the recognizer entities are extracted with, by
+// default a GazetteerRecognizer with the DefaultGazetteer
+func (s *Server) WithRecognizer(r Recognizer) *Server {
+	s.recognizer = r
+	return s
+}
+
+// WithTagger replaces the tagger parts of speech are assigned with, by
+// default an HMMTagger with the DefaultTagLexicon
+func (s *Server) WithTagger(t Tagger) *Server {
+	s.tagger = t
+	return s
+}
+
+// WithAspectAnalyzer replaces the analyzer of aspect-based sentiment, by
+// default a LexiconAspectAnalyzer with the DefaultTaxonomy
+func (s *Server) WithAspectAnalyzer(a AspectAnalyzer) *Server {
+	s.aspects = a
+	return s
+}
+
+// Register registers the NLPManager service on a gRPC server
+func (s *Server) Register(r grpc.ServiceRegistrar) {
+	pb.RegisterNLPManagerServer(r, s)
+}
+
+// AnalyzeSentiment scores a single text
+func (s *Server) AnalyzeSentiment(ctx context.Context, req *pb.SentimentRequest) (*pb.SentimentResponse, error) {
+	return s.analyze(ctx, req)
+}
+
+// AnalyzeSentimentBatch scores every item independently, reporting failures per item
+func (s *Server) AnalyzeSentimentBatch(ctx context.Context, req *pb.SentimentBatchRequest) (*pb.SentimentBatchResponse, error) {
+	results := make([]*pb.SentimentBatchResult, len(req.Items))
+	for i, item := range req.Items {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		result := &pb.SentimentBatchResult{Index: int32(i)}
+		if resp, err := s.analyze(ctx, item); err != nil {
+			result.Outcome = &pb.SentimentBatchResult_Error{Error: itemError(err)}
+		} else {
+			result.Outcome = &pb.SentimentBatchResult_Response{Response: resp}
+		}
+		results[i] = result
+	}
+	return &pb.SentimentBatchResponse{Results: results}, nil
+}
+
+// StreamSentiment answers stream requests in order. Requests are read one at a
+// time, so a slow scorer applies gRPC flow control back to the client.
+func (s *Server) StreamSentiment(stream pb.NLPManager_StreamSentimentServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp := &pb.SentimentStreamResponse{Id: req.Id}
+		if req.Request == nil {
+			resp.Outcome = &pb.SentimentStreamResponse_Error{Error: itemError(status.Error(codes.InvalidArgument, "request is required"))}
+		} else if result, err := s.analyze(stream.Context(), req.Request); err != nil {
+			resp.Outcome = &pb.SentimentStreamResponse_Error{Error: itemError(err)}
+		} else {
+			resp.Outcome = &pb.SentimentStreamResponse_Response{Response: result}
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) analyze(ctx context.Context, req *pb.SentimentRequest) (*pb.SentimentResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.scorer.Score(ctx, req.Text, req.Lang)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "sentiment analysis failed: %v", err)
+	}
+	return resp, nil
+}
+
+// checkText rejects empty texts and languages other than Persian
+func checkText(text, lang string) error {
+	if strings.TrimSpace(text) == "" {
+		return status.Error(codes.InvalidArgument, "text is required")
+	}
+	if !supportedLanguage(lang) {
+		return status.Errorf(codes.InvalidArgument, "unsupported language %q", lang)
+	}
+	return nil
+}
+
+// supportedLanguage accepts Persian, which is also assumed when no language is given
+func supportedLanguage(lang string) bool {
+	lang = strings.ToLower(lang)
+	return lang == "" || lang == "fa" || strings.HasPrefix(lang, "fa-") || strings.HasPrefix(lang, "fa_")
+}
+
+func itemError(err error) *pb.ItemError {
+	st := status.Convert(err)
+	return &pb.ItemError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+}
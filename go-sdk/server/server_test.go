@@ -0,0 +1,100 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLexiconScorer(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want pb.SentimentLabel
+	}{
+		{"positive review", "این محصول عالی است و من خیلی راضی هستم", pb.SentimentLabel_SENTIMENT_LABEL_POSITIVE},
+		{"negative review", "کیفیت بسیار بد بود و اصلا توصیه نمی‌کنم", pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE},
+		{"negated positive", "بسیار ناامید شدم، این محصول ارزش خرید ندارد", pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE},
+		{"negated adjective", "غذا خوب نبود", pb.SentimentLabel_SENTIMENT_LABEL_NEGATIVE},
+		{"arabic code points", "كيفيت عالي بود", pb.SentimentLabel_SENTIMENT_LABEL_POSITIVE},
+		{"mixed review", "غذا عالی بود ولی ارسال افتضاح بود", pb.SentimentLabel_SENTIMENT_LABEL_MIXED},
+		{"no opinion", "سفارش را دیروز ثبت کردم", pb.SentimentLabel_SENTIMENT_LABEL_NEUTRAL},
+	}
+
+	scorer := NewLexiconScorer()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := scorer.Score(context.Background(), tt.text, "fa")
+			if err != nil {
+				t.Fatalf("Score() error = %v", err)
+			}
+			if resp.Sentiment != tt.want {
+				t.Errorf("Score() sentiment = %v, want %v (probabilities %v)", resp.Sentiment, tt.want, resp.Probabilities)
+			}
+
+			var sum float64
+			for _, p := range resp.Probabilities {
+				sum += p
+			}
+			if sum < 0.999 || sum > 1.001 {
+				t.Errorf("probabilities should sum to 1, got %f", sum)
+			}
+		})
+	}
+}
+
+func TestAnalyzeSentimentBatchReportsItemErrors(t *testing.T) {
+	srv := New()
+	resp, err := srv.AnalyzeSentimentBatch(context.Background(), &pb.SentimentBatchRequest{
+		Items: []*pb.SentimentRequest{
+			{Text: "عالی بود", Lang: "fa"},
+			{Text: "", Lang: "fa"},
+			{Text: "great", Lang: "en"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("AnalyzeSentimentBatch() error = %v", err)
+	}
+	if len(resp.Results) != 3 {
+		t.Fatalf("expected 3 results, got %d", len(resp.Results))
+	}
+
+	if resp.Results[0].GetResponse() == nil {
+		t.Errorf("item 0 should succeed, got %v", resp.Results[0].GetError())
+	}
+	for _, idx := range []int{1, 2} {
+		itemErr := resp.Results[idx].GetError()
+		if itemErr == nil || codes.Code(itemErr.Code) != codes.InvalidArgument {
+			t.Errorf("item %d should fail with InvalidArgument, got %v", idx, resp.Results[idx])
+		}
+	}
+}
+
+func TestLemmatize(t *testing.T) {
+	resp, err := New().Lemmatize(context.Background(), &pb.LemmatizeRequest{Text: "غذاها دیر رسیدند.", Lang: "fa"})
+	if err != nil {
+		t.Fatalf("Lemmatize() error = %v", err)
+	}
+
+	want := []*pb.Lemma{
+		{Word: "غذاها", Stem: "غذا", Lemma: "غذا", Start: 0, End: 5},
+		{Word: "دیر", Stem: "دیر", Lemma: "دیر", Start: 6, End: 9},
+		{Word: "رسیدند", Stem: "رسید", Lemma: "رسیدن", Start: 10, End: 16},
+	}
+	if len(resp.Lemmas) != len(want) {
+		t.Fatalf("Lemmatize() returned %d lemmas, want %d", len(resp.Lemmas), len(want))
+	}
+	for i, w := range want {
+		if !proto.Equal(resp.Lemmas[i], w) {
+			t.Errorf("lemma %d = %v, want %v", i, resp.Lemmas[i], w)
+		}
+	}
+
+	if _, err := New().Lemmatize(context.Background(), &pb.LemmatizeRequest{Text: " "}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument for empty text, got %v", err)
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/lemmatize"
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+)
+
+// Tagger assigns parts of speech to the tokens of a single text
+type Tagger interface {
+	Tag(ctx context.Context, text, lang string) ([]*pb.TaggedToken, error)
+}
+
+// HMMTagger is a dependency-free tagger based on a hidden Markov model. Tag
+// transitions are estimated by hand for Persian word order, and the tags a
+// word can take come from a lexicon of closed-class and common words, verb
+// conjugations and suffixes. It is meant as a fallback when no trained model
+// is available.
+type HMMTagger struct {
+	lexicon map[string][]tagScore
+}
+
+// tagScore is a tag a word can take with its probability
+type tagScore struct {
+	tag pb.PartOfSpeech
+	p   float64
+}
+
+// NewHMMTagger creates a tagger for the words of l, e.g. DefaultTagLexicon
+// extended with the dishes of a menu. Words listed under several tags are
+// equally likely to take each of them.
+func NewHMMTagger(l TagLexicon) *HMMTagger {
+	tags := make(map[string][]pb.PartOfSpeech)
+	for tag, words := range l {
+		for _, w := range words {
+			key := matchKey(w)
+			tags[key] = append(tags[key], tag)
+		}
+	}
+
+	t := &HMMTagger{lexicon: make(map[string][]tagScore, len(tags))}
+	for key, ts := range tags {
+		for _, tag := range ts {
+			t.lexicon[key] = append(t.lexicon[key], tagScore{tag, 1 / float64(len(ts))})
+		}
+	}
+	return t
+}
+
+// Tag implements Tagger
+func (t *HMMTagger) Tag(ctx context.Context, text, lang string) ([]*pb.TaggedToken, error) {
+	toks := tokenize.Tokenize(text)
+	candidates := make([][]tagScore, len(toks))
+	for i, tok := range toks {
+		candidates[i] = t.candidates(tok)
+	}
+
+	tags := viterbi(candidates)
+	tagged := make([]*pb.TaggedToken, len(toks))
+	for i, tok := range toks {
+		tagged[i] = &pb.TaggedToken{
+			Text:  tok.Text,
+			Tag:   tags[i],
+			Start: int32(tok.RuneStart),
+			End:   int32(tok.RuneEnd),
+		}
+		if tok.Kind == tokenize.Word {
+			tagged[i].Lemma = lemmatize.Lemma(tok.Text)
+		}
+	}
+	return tagged, nil
+}
+
+// Tags of tokens the lexicon does not decide
+var (
+	copulaTags  = []tagScore{{aux, 0.7}, {verb, 0.3}}
+	verbTags    = []tagScore{{verb, 0.9}, {noun, 0.1}}
+	adjTags     = []tagScore{{adj, 0.9}, {noun, 0.1}}
+	nounTags    = []tagScore{{noun, 0.9}, {adj, 0.1}}
+	foreignTags = []tagScore{{propn, 0.4}, {noun, 0.3}, {x, 0.3}}
+	unknownTags = []tagScore{{noun, 0.55}, {adj, 0.3}, {propn, 0.1}, {adv, 0.05}}
+)
+
+// candidates returns the tags token can take
+func (t *HMMTagger) candidates(tok tokenize.Token) []tagScore {
+	switch tok.Kind {
+	case tokenize.Number:
+		return []tagScore{{num, 1}}
+	case tokenize.Punctuation:
+		return []tagScore{{punct, 1}}
+	case tokenize.Symbol, tokenize.Emoji:
+		return []tagScore{{sym, 1}}
+	case tokenize.URL, tokenize.Email, tokenize.Hashtag, tokenize.Mention:
+		return []tagScore{{x, 1}}
+	}
+
+	if tags, ok := t.lexicon[matchKey(tok.Text)]; ok {
+		return tags
+	}
+	if r, _ := utf8.DecodeRuneInString(tok.Text); !unicode.Is(unicode.Arabic, r) {
+		return foreignTags
+	}
+	lemma := lemmatize.Lemma(tok.Text)
+	if lemmatize.IsVerb(tok.Text) {
+		if lemma == "بودن" {
+			return copulaTags
+		}
+		return verbTags
+	}
+	// Inflected forms take the tags of their lemma
+	if tags, ok := t.lexicon[matchKey(lemma)]; ok {
+		return tags
+	}
+	if len(lemma) < len(tok.Text) {
+		word := strings.TrimRight(tok.Text, "ی")
+		if strings.HasSuffix(word, "تر") || strings.HasSuffix(word, "ترین") {
+			return adjTags
+		}
+		return nounTags
+	}
+	return unknownTags
+}
+
+// viterbi returns the most likely tag of every token given their candidates
+func viterbi(candidates [][]tagScore) []pb.PartOfSpeech {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// best[i][j] is the log probability of the most likely tags of tokens 0
+	// to i with token i tagged candidates[i][j], and back[i][j] the candidate
+	// of token i-1 on that path
+	best := make([][]float64, len(candidates))
+	back := make([][]int, len(candidates))
+	for i, cs := range candidates {
+		best[i] = make([]float64, len(cs))
+		back[i] = make([]int, len(cs))
+		for j, c := range cs {
+			emission := math.Log(c.p)
+			if i == 0 {
+				best[i][j] = transitions[sentenceStart][c.tag] + emission
+				continue
+			}
+			best[i][j] = math.Inf(-1)
+			for k, prev := range candidates[i-1] {
+				if p := best[i-1][k] + transitions[prev.tag][c.tag] + emission; p > best[i][j] {
+					best[i][j], back[i][j] = p, k
+				}
+			}
+		}
+	}
+
+	last := len(candidates) - 1
+	j := 0
+	for k := range best[last] {
+		if best[last][k] > best[last][j] {
+			j = k
+		}
+	}
+	tags := make([]pb.PartOfSpeech, len(candidates))
+	for i := last; i >= 0; i-- {
+		tags[i] = candidates[i][j].tag
+		j = back[i][j]
+	}
+	return tags
+}
@@ -0,0 +1,72 @@
+package server
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+func TestHMMTagger(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{"copula", "غذا سرد بود و دیر رسید.", "NOUN ADJ AUX CCONJ ADV VERB PUNCT"},
+		{"determiner and adverb", "این غذا خیلی خوشمزه بود", "DET NOUN ADV ADJ AUX"},
+		{"pronoun", "این را دوست دارم", "PRON ADP NOUN VERB"},
+		{"object marker before verb", "من کتاب را خواندم", "PRON NOUN ADP VERB"},
+		{"inflected forms", "قیمت‌ها گران‌تر شده‌اند!", "NOUN ADJ VERB PUNCT"},
+		{"future", "من فردا به تهران خواهم رفت", "PRON NOUN ADP NOUN AUX VERB"},
+		{"symbols and numbers", "۲ پیتزا سفارش دادم 👍 #اسنپ‌فود", "NUM NOUN NOUN VERB SYM X"},
+	}
+
+	tagger := NewHMMTagger(DefaultTagLexicon())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens, err := tagger.Tag(context.Background(), tt.text, "fa")
+			if err != nil {
+				t.Fatalf("Tag() error = %v", err)
+			}
+			tags := make([]string, len(tokens))
+			for i, tok := range tokens {
+				tags[i] = strings.TrimPrefix(tok.Tag.String(), "PART_OF_SPEECH_")
+			}
+			if got := strings.Join(tags, " "); got != tt.want {
+				t.Errorf("Tag(%q) = %s, want %s", tt.text, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHMMTaggerLexicon(t *testing.T) {
+	l := DefaultTagLexicon()
+	l.Add(pb.PartOfSpeech_PART_OF_SPEECH_PROPN, "اسنپ‌فود")
+
+	tokens, err := NewHMMTagger(l).Tag(context.Background(), "پیتزاهای اسنپ‌فود عالی بودند", "fa")
+	if err != nil {
+		t.Fatalf("Tag() error = %v", err)
+	}
+	want := []struct {
+		tag   pb.PartOfSpeech
+		lemma string
+	}{
+		{pb.PartOfSpeech_PART_OF_SPEECH_NOUN, "پیتزا"},
+		{pb.PartOfSpeech_PART_OF_SPEECH_PROPN, "اسنپ‌فود"},
+		{pb.PartOfSpeech_PART_OF_SPEECH_ADJ, "عالی"},
+		{pb.PartOfSpeech_PART_OF_SPEECH_AUX, "بودن"},
+	}
+	if len(tokens) != len(want) {
+		t.Fatalf("Tag() = %v, want %d tokens", tokens, len(want))
+	}
+	for i, w := range want {
+		if tokens[i].Tag != w.tag || tokens[i].Lemma != w.lemma {
+			t.Errorf("token %d = %v %q, want %v %q", i, tokens[i].Tag, tokens[i].Lemma, w.tag, w.lemma)
+		}
+	}
+	if tokens[1].Start != 9 || tokens[1].End != 17 {
+		t.Errorf("offsets of %q = %d-%d, want 9-17", tokens[1].Text, tokens[1].Start, tokens[1].End)
+	}
+}
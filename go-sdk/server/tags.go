@@ -0,0 +1,135 @@
+package server
+
+import (
+	"math"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+)
+
+// TagLexicon lists words by the parts of speech they can take. A word may be
+// listed under several tags, such as این, a determiner or a pronoun.
+type TagLexicon map[pb.PartOfSpeech][]string
+
+// Add adds words that can take the given tag
+func (l TagLexicon) Add(tag pb.PartOfSpeech, words ...string) {
+	l[tag] = append(l[tag], words...)
+}
+
+// Short names of the tags for the tables below
+const (
+	adj   = pb.PartOfSpeech_PART_OF_SPEECH_ADJ
+	adp   = pb.PartOfSpeech_PART_OF_SPEECH_ADP
+	adv   = pb.PartOfSpeech_PART_OF_SPEECH_ADV
+	aux   = pb.PartOfSpeech_PART_OF_SPEECH_AUX
+	cconj = pb.PartOfSpeech_PART_OF_SPEECH_CCONJ
+	det   = pb.PartOfSpeech_PART_OF_SPEECH_DET
+	intj  = pb.PartOfSpeech_PART_OF_SPEECH_INTJ
+	noun  = pb.PartOfSpeech_PART_OF_SPEECH_NOUN
+	num   = pb.PartOfSpeech_PART_OF_SPEECH_NUM
+	part  = pb.PartOfSpeech_PART_OF_SPEECH_PART
+	pron  = pb.PartOfSpeech_PART_OF_SPEECH_PRON
+	propn = pb.PartOfSpeech_PART_OF_SPEECH_PROPN
+	punct = pb.PartOfSpeech_PART_OF_SPEECH_PUNCT
+	sconj = pb.PartOfSpeech_PART_OF_SPEECH_SCONJ
+	sym   = pb.PartOfSpeech_PART_OF_SPEECH_SYM
+	verb  = pb.PartOfSpeech_PART_OF_SPEECH_VERB
+	x     = pb.PartOfSpeech_PART_OF_SPEECH_X
+
+	// sentenceStart is the state before the first token
+	sentenceStart = pb.PartOfSpeech_PART_OF_SPEECH_UNSPECIFIED
+)
+
+// DefaultTagLexicon returns a new lexicon with the closed word classes of
+// Persian and common nouns and adjectives of reviews
+func DefaultTagLexicon() TagLexicon {
+	return TagLexicon{
+		adp: {
+			"از", "به", "با", "در", "بر", "تا", "برای", "بدون", "مثل", "مانند", "روی", "زیر",
+			"کنار", "پیش", "بین", "درباره", "توسط", "جز", "داخل", "سمت", "طرف", "نزد", "را",
+		},
+		cconj: {"و", "یا", "اما", "ولی", "لیکن", "بلکه"},
+		sconj: {"که", "اگر", "چون", "چونکه", "زیرا", "وقتی", "تا", "هرچند", "گرچه", "مگر"},
+		pron: {
+			"من", "تو", "او", "ما", "شما", "آنها", "اینها", "ایشان", "وی", "خود", "خودم", "خودت",
+			"خودش", "خودمان", "خودتان", "خودشان", "چیزی", "کسی", "هیچکس", "همه", "این", "آن", "چه",
+		},
+		det: {
+			"این", "آن", "هر", "همه", "هیچ", "چند", "چندین", "چه", "کدام", "همین", "همان", "برخی",
+			"بعضی", "تمام",
+		},
+		adv: {
+			"خیلی", "بسیار", "واقعا", "کاملا", "اصلا", "هم", "نیز", "هنوز", "دیگر", "فقط", "همیشه",
+			"هرگز", "اکنون", "الان", "حالا", "بعدا", "قبلا", "حتما", "شاید", "اینجا", "آنجا", "چرا",
+			"چطور", "کجا", "چقدر", "تقریبا", "نسبتا", "کمی", "لطفا", "دوباره", "باز", "کاش", "زود", "دیر",
+			"زیاد", "کم", "بیشتر", "سریع",
+		},
+		aux:  {"خواهم", "خواهی", "خواهد", "خواهیم", "خواهید", "خواهند", "باید", "نباید"},
+		intj: {"آه", "وای", "آخ", "اوه", "آفرین", "بله", "آره", "نه", "مرسی", "سلام"},
+		part: {"آیا"},
+		num: {
+			"یک", "دو", "سه", "چهار", "پنج", "شش", "هفت", "هشت", "نه", "ده", "بیست", "سی",
+			"چهل", "پنجاه", "صد", "هزار", "میلیون", "میلیارد", "نیم",
+		},
+		noun: {
+			"غذا", "پیتزا", "برگر", "ساندویچ", "کباب", "برنج", "مرغ", "گوشت", "ماهی", "سالاد",
+			"سوپ", "نوشابه", "دوغ", "سس", "نان", "پنیر", "کیک", "قهوه", "چای", "میوه", "دسر",
+			"رستوران", "سفارش", "ارسال", "پیک", "بسته", "بسته‌بندی", "ظرف", "قیمت", "کیفیت",
+			"طعم", "مزه", "حجم", "اندازه", "تخفیف", "هزینه", "پول", "تومان", "کالا", "محصول",
+			"جنس", "گوشی", "فروشگاه", "فروشنده", "مشتری", "راننده", "پشتیبانی", "خدمات",
+			"برنامه", "اپلیکیشن", "سرویس", "آدم", "مرد", "زن", "دختر", "پسر", "بچه", "دوست",
+			"مردم", "خانه", "شهر", "کشور", "روز", "شب", "هفته", "ماه", "سال", "ساعت", "دقیقه",
+			"وقت", "زمان", "بار", "کار", "دست", "اسم", "کتاب", "فیلم",
+		},
+		adj: {
+			"خوب", "بد", "عالی", "ضعیف", "بزرگ", "کوچک", "سرد", "گرم", "داغ", "تازه", "سریع",
+			"زیبا", "زشت", "خوشمزه", "بی‌مزه", "شور", "شیرین", "تلخ", "ترش", "تند", "تمیز",
+			"کثیف", "ارزان", "گران", "آسان", "سخت", "مهربان", "جوان", "قوی", "زیاد", "کم",
+			"نرم", "سفت", "سالم", "خراب", "جدید", "قدیمی", "کهنه", "بلند", "کوتاه", "مناسب",
+			"راضی", "ناراضی", "محشر", "افتضاح", "خسته", "عجیب", "معمولی", "متوسط",
+			"باکیفیت", "بی‌کیفیت", "خوشگل", "خنک", "دیر", "زود",
+		},
+	}
+}
+
+// transitionWeights are the relative frequencies of a tag following another,
+// estimated for Persian, where verbs end the clause and adjectives follow
+// their noun. Pairs that are not listed get minTransition.
+var transitionWeights = map[pb.PartOfSpeech]map[pb.PartOfSpeech]float64{
+	sentenceStart: {noun: 0.3, pron: 0.1, det: 0.1, adp: 0.1, adv: 0.1, adj: 0.05, verb: 0.05, sconj: 0.05, num: 0.05, propn: 0.05, cconj: 0.03, intj: 0.03, part: 0.02},
+	noun:          {adj: 0.25, noun: 0.15, adp: 0.15, verb: 0.12, aux: 0.08, punct: 0.08, cconj: 0.06, sconj: 0.04, det: 0.03, pron: 0.02, adv: 0.02, num: 0.02},
+	adj:           {aux: 0.3, punct: 0.15, verb: 0.1, noun: 0.1, cconj: 0.1, adj: 0.05, adp: 0.05, sconj: 0.05, adv: 0.03},
+	adv:           {adj: 0.35, verb: 0.2, adv: 0.1, noun: 0.1, aux: 0.05, adp: 0.05, det: 0.05},
+	adp:           {noun: 0.45, pron: 0.15, verb: 0.12, det: 0.1, propn: 0.1, num: 0.05, adj: 0.05, adv: 0.03},
+	det:           {noun: 0.7, adj: 0.05, num: 0.05},
+	pron:          {verb: 0.2, adp: 0.15, noun: 0.15, adv: 0.1, adj: 0.1, aux: 0.1, punct: 0.05},
+	verb:          {punct: 0.35, cconj: 0.15, sconj: 0.15, noun: 0.1, adp: 0.05, adv: 0.05},
+	aux:           {punct: 0.4, cconj: 0.2, sconj: 0.1, noun: 0.1, verb: 0.05},
+	cconj:         {noun: 0.3, adj: 0.15, adv: 0.1, pron: 0.1, det: 0.1, adp: 0.1, verb: 0.1, propn: 0.05},
+	sconj:         {noun: 0.3, pron: 0.15, adv: 0.1, det: 0.1, adp: 0.1, verb: 0.1, adj: 0.05},
+	num:           {noun: 0.6, punct: 0.1, adj: 0.05, adp: 0.05},
+	propn:         {propn: 0.2, adp: 0.15, noun: 0.1, verb: 0.1, aux: 0.1, punct: 0.1, cconj: 0.05},
+	punct:         {noun: 0.25, pron: 0.1, det: 0.1, adv: 0.1, cconj: 0.1, adp: 0.1, sconj: 0.05, punct: 0.05, verb: 0.05},
+	intj:          {punct: 0.4, noun: 0.2, adj: 0.1},
+	part:          {verb: 0.3, noun: 0.3},
+	sym:           {punct: 0.2, noun: 0.2},
+	x:             {noun: 0.2, punct: 0.2},
+}
+
+const minTransition = 0.01
+
+// transitions holds the log probability of a tag following another
+var transitions [x + 1][x + 1]float64
+
+func init() {
+	for from := sentenceStart; from <= x; from++ {
+		var weights [x + 1]float64
+		total := 0.0
+		for to := adj; to <= x; to++ {
+			weights[to] = max(transitionWeights[from][to], minTransition)
+			total += weights[to]
+		}
+		for to := adj; to <= x; to++ {
+			transitions[from][to] = math.Log(weights[to] / total)
+		}
+	}
+}
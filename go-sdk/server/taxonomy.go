@@ -0,0 +1,61 @@
+package server
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Taxonomy lists the terms of every aspect category, e.g. پیک and ارسال for
+// delivery. Terms may span several words and match their inflected forms.
+type Taxonomy map[string][]string
+
+// Add adds terms of the given category
+func (t Taxonomy) Add(category string, terms ...string) {
+	t[category] = append(t[category], terms...)
+}
+
+// Load adds the terms read from r. Every line holds a category and a term
+// separated by a tab. Blank lines and lines starting with # are skipped.
+func (t Taxonomy) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		category, term, ok := strings.Cut(line, "\t")
+		category, term = strings.ToLower(strings.TrimSpace(category)), strings.TrimSpace(term)
+		if !ok || category == "" || term == "" {
+			return fmt.Errorf("invalid taxonomy line %d: %q", n, line)
+		}
+		t.Add(category, term)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read taxonomy: %w", err)
+	}
+	return nil
+}
+
+// DefaultTaxonomy returns a new taxonomy for food delivery reviews, the domain
+// of the snappfood dataset, with the categories food, delivery, price and
+// packaging
+func DefaultTaxonomy() Taxonomy {
+	return Taxonomy{
+		"food": {
+			"غذا", "طعم", "مزه", "پخت", "پرس", "حجم غذا", "پیتزا", "برگر", "همبرگر", "ساندویچ",
+			"کباب", "جوجه", "برنج", "مرغ", "گوشت", "ماهی", "سالاد", "سوپ", "نوشابه", "دوغ",
+			"سس", "نان", "پنیر", "سیب‌زمینی", "کیک", "شیرینی", "دسر", "قهوه", "چای", "نوشیدنی",
+		},
+		"delivery": {
+			"ارسال", "پیک", "تحویل", "راننده", "زمان ارسال", "زمان تحویل", "سرعت ارسال",
+		},
+		"price": {
+			"قیمت", "هزینه", "هزینه ارسال", "پول", "تخفیف", "فاکتور",
+		},
+		"packaging": {
+			"بسته‌بندی", "بسته", "ظرف", "جعبه", "کیسه", "پلاستیک",
+		},
+	}
+}
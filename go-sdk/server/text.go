@@ -0,0 +1,133 @@
+package server
+
+import (
+	"context"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/lemmatize"
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Lemmatize returns the stem and lemma of every word of the text
+func (s *Server) Lemmatize(ctx context.Context, req *pb.LemmatizeRequest) (*pb.LemmatizeResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+
+	words := lemmatize.Lemmatize(req.Text)
+	lemmas := make([]*pb.Lemma, len(words))
+	for i, w := range words {
+		lemmas[i] = &pb.Lemma{
+			Word:  w.Text,
+			Stem:  w.Stem,
+			Lemma: w.Lemma,
+			Start: int32(w.RuneStart),
+			End:   int32(w.RuneEnd),
+		}
+	}
+	return &pb.LemmatizeResponse{Lemmas: lemmas}, nil
+}
+
+// Tokenize splits the text into tokens
+func (s *Server) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+	return &pb.TokenizeResponse{Tokens: tokens(tokenize.Tokenize(req.Text))}, nil
+}
+
+// SegmentSentences splits the text into sentences with their tokens
+func (s *Server) SegmentSentences(ctx context.Context, req *pb.SegmentSentencesRequest) (*pb.SegmentSentencesResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+
+	segmented := tokenize.SegmentSentences(req.Text)
+	sentences := make([]*pb.Sentence, len(segmented))
+	for i, sent := range segmented {
+		sentences[i] = &pb.Sentence{
+			Text:   sent.Text,
+			Tokens: tokens(sent.Tokens),
+			Start:  int32(sent.RuneStart),
+			End:    int32(sent.RuneEnd),
+		}
+	}
+	return &pb.SegmentSentencesResponse{Sentences: sentences}, nil
+}
+
+// ExtractEntities finds the named entities of the text
+func (s *Server) ExtractEntities(ctx context.Context, req *pb.ExtractEntitiesRequest) (*pb.ExtractEntitiesResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+
+	entities, err := s.recognizer.Recognize(ctx, req.Text, req.Lang)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "entity extraction failed: %v", err)
+	}
+	return &pb.ExtractEntitiesResponse{Entities: entities}, nil
+}
+
+// TagPartsOfSpeech returns the tokens of the text with their parts of speech
+func (s *Server) TagPartsOfSpeech(ctx context.Context, req *pb.TagPartsOfSpeechRequest) (*pb.TagPartsOfSpeechResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+
+	tokens, err := s.tagger.Tag(ctx, req.Text, req.Lang)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "part-of-speech tagging failed: %v", err)
+	}
+	return &pb.TagPartsOfSpeechResponse{Tokens: tokens}, nil
+}
+
+// AnalyzeAspects returns the sentiment the text expresses about each aspect
+// it mentions
+func (s *Server) AnalyzeAspects(ctx context.Context, req *pb.AnalyzeAspectsRequest) (*pb.AnalyzeAspectsResponse, error) {
+	if err := checkText(req.Text, req.Lang); err != nil {
+		return nil, err
+	}
+
+	aspects, err := s.aspects.AnalyzeAspects(ctx, req.Text, req.Lang)
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Errorf(codes.Internal, "aspect analysis failed: %v", err)
+	}
+	return &pb.AnalyzeAspectsResponse{Aspects: aspects}, nil
+}
+
+var tokenKinds = map[tokenize.Kind]pb.TokenKind{
+	tokenize.Word:        pb.TokenKind_TOKEN_KIND_WORD,
+	tokenize.Number:      pb.TokenKind_TOKEN_KIND_NUMBER,
+	tokenize.Punctuation: pb.TokenKind_TOKEN_KIND_PUNCTUATION,
+	tokenize.Symbol:      pb.TokenKind_TOKEN_KIND_SYMBOL,
+	tokenize.Emoji:       pb.TokenKind_TOKEN_KIND_EMOJI,
+	tokenize.URL:         pb.TokenKind_TOKEN_KIND_URL,
+	tokenize.Email:       pb.TokenKind_TOKEN_KIND_EMAIL,
+	tokenize.Hashtag:     pb.TokenKind_TOKEN_KIND_HASHTAG,
+	tokenize.Mention:     pb.TokenKind_TOKEN_KIND_MENTION,
+}
+
+// tokens converts tokens to their wire form, with offsets in runes
+func tokens(toks []tokenize.Token) []*pb.Token {
+	out := make([]*pb.Token, len(toks))
+	for i, tok := range toks {
+		out[i] = &pb.Token{
+			Text:  tok.Text,
+			Kind:  tokenKinds[tok.Kind],
+			Start: int32(tok.RuneStart),
+			End:   int32(tok.RuneEnd),
+		}
+	}
+	return out
+}
@@ -0,0 +1,173 @@
+package go_sdk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DefaultStreamWindow is the default number of requests that may await a response on a stream
+const DefaultStreamWindow = 64
+
+// StreamResult is the outcome of a single request sent on a SentimentStream
+type StreamResult struct {
+	ID     string
+	Result *Result
+	Err    error
+}
+
+// SentimentStream is a bidirectional sentiment analysis stream.
+//
+// Send blocks once the window of unanswered requests is full or when gRPC flow
+// control pushes back, so a slow engine slows producers down instead of letting
+// requests pile up in memory. Because responses free up the window, Recv must be
+// called from a different goroutine than Send. Send must not be called
+// concurrently with itself, nor Recv with itself.
+type SentimentStream struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	stream pb.NLPManager_StreamSentimentClient
+	window chan struct{}
+
+	mu      sync.Mutex
+	pending map[string]struct{}
+	err     error
+}
+
+// Stream opens a sentiment stream with the default window
+func (c *Client) Stream(ctx context.Context) (*SentimentStream, error) {
+	return c.StreamWithWindow(ctx, DefaultStreamWindow)
+}
+
+// StreamWithWindow opens a sentiment stream allowing at most window unanswered requests
+func (c *Client) StreamWithWindow(ctx context.Context, window int) (*SentimentStream, error) {
+	if window <= 0 {
+		window = DefaultStreamWindow
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := c.client.StreamSentiment(ctx)
+	if err != nil {
+		cancel()
+		return nil, fmt.Errorf("failed to open sentiment stream: %w", err)
+	}
+
+	return &SentimentStream{
+		ctx:     ctx,
+		cancel:  cancel,
+		stream:  stream,
+		window:  make(chan struct{}, window),
+		pending: make(map[string]struct{}),
+	}, nil
+}
+
+// Send queues text for analysis in Persian under the given request ID
+func (s *SentimentStream) Send(id, text string) error {
+	return s.SendWithLanguage(id, text, "fa")
+}
+
+// SendWithLanguage queues text for analysis in the given language under the given request ID.
+// IDs must be unique among requests that have not been answered yet.
+func (s *SentimentStream) SendWithLanguage(id, text, lang string) error {
+	select {
+	case s.window <- struct{}{}:
+	case <-s.ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if s.err != nil {
+			return fmt.Errorf("sentiment stream failed: %w", s.err)
+		}
+		return s.ctx.Err()
+	}
+
+	s.mu.Lock()
+	if _, ok := s.pending[id]; ok {
+		s.mu.Unlock()
+		<-s.window
+		return fmt.Errorf("request %q is already in flight", id)
+	}
+	s.pending[id] = struct{}{}
+	s.mu.Unlock()
+
+	err := s.stream.Send(&pb.SentimentStreamRequest{
+		Id: id,
+		Request: &pb.SentimentRequest{
+			Text: text,
+			Lang: lang,
+		},
+	})
+	if err != nil {
+		s.release(id)
+		return fmt.Errorf("failed to send stream request %q: %w", id, err)
+	}
+	return nil
+}
+
+// Recv waits for the next response. It returns io.EOF once the server has answered
+// every request and CloseSend has been called. Any other error ends the
+// stream, and a Send blocked on the window returns it.
+func (s *SentimentStream) Recv() (*StreamResult, error) {
+	resp, err := s.stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if err != nil {
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+		s.cancel()
+		return nil, err
+	}
+
+	if !s.release(resp.Id) {
+		return nil, fmt.Errorf("received response for unknown request %q", resp.Id)
+	}
+
+	result := &StreamResult{ID: resp.Id}
+	switch outcome := resp.Outcome.(type) {
+	case *pb.SentimentStreamResponse_Response:
+		result.Result = newResult(outcome.Response)
+	case *pb.SentimentStreamResponse_Error:
+		result.Err = status.Error(codes.Code(outcome.Error.Code), outcome.Error.Message)
+	default:
+		result.Err = status.Error(codes.Internal, "empty stream response")
+	}
+	return result, nil
+}
+
+// Pending returns the number of requests that have not been answered yet
+func (s *SentimentStream) Pending() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.pending)
+}
+
+// CloseSend signals that no more requests will be sent. Pending responses can still be received.
+func (s *SentimentStream) CloseSend() error {
+	return s.stream.CloseSend()
+}
+
+// Close aborts the stream, discarding any pending responses
+func (s *SentimentStream) Close() error {
+	s.cancel()
+	return nil
+}
+
+// release forgets a pending request and frees its slot in the window
+func (s *SentimentStream) release(id string) bool {
+	s.mu.Lock()
+	_, ok := s.pending[id]
+	delete(s.pending, id)
+	s.mu.Unlock()
+
+	if ok {
+		<-s.window
+	}
+	return ok
+}
@@ -0,0 +1,96 @@
+package go_sdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamCorrelatesResponsesByID(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	client := srv.Client(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.StreamWithWindow(ctx, 2)
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	defer stream.Close()
+
+	texts := map[string]string{}
+	for i := 0; i < 10; i++ {
+		texts[fmt.Sprint(i)] = "غذا عالی بود"
+	}
+
+	go func() {
+		for id, text := range texts {
+			if err := stream.Send(id, text); err != nil {
+				t.Errorf("Send() error = %v", err)
+				return
+			}
+		}
+		stream.CloseSend()
+	}()
+
+	seen := map[string]bool{}
+	for {
+		res, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Recv() error = %v", err)
+		}
+		if res.Err != nil || !res.Result.IsPositive() {
+			t.Errorf("unexpected result for %s: %v %v", res.ID, res.Result, res.Err)
+		}
+		seen[res.ID] = true
+	}
+
+	if len(seen) != len(texts) {
+		t.Errorf("expected %d responses, got %d", len(texts), len(seen))
+	}
+	if p := stream.Pending(); p != 0 {
+		t.Errorf("expected no pending requests, got %d", p)
+	}
+}
+
+func TestStreamFailureUnblocksSend(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("خراب").Fail(codes.Internal, "engine crashed").AbortStream()
+	client := srv.Client(t)
+
+	stream, err := client.StreamWithWindow(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("Stream() error = %v", err)
+	}
+	defer stream.Close()
+
+	if err := stream.Send("1", "خراب"); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	// The window is full, so this Send waits until the stream fails
+	sent := make(chan error, 1)
+	go func() { sent <- stream.Send("2", "خوب") }()
+
+	if _, err := stream.Recv(); status.Code(err) != codes.Internal {
+		t.Fatalf("expected the stream to fail with Internal, got %v", err)
+	}
+	select {
+	case err := <-sent:
+		if status.Code(err) != codes.Internal {
+			t.Errorf("expected the blocked Send to return the stream error, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send is still blocked after the stream failed")
+	}
+}
@@ -0,0 +1,73 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/codes"
+)
+
+func TestTelemetryRecordsSpansAndMetrics(t *testing.T) {
+	srv := zennlptest.NewServer(t)
+	srv.On("عالی بود").Fail(codes.Unavailable, "engine restarting").Times(1)
+
+	spans := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	client := srv.ClientWithConfig(t, go_sdk.Config{
+		Timeout:        5 * time.Second,
+		Retry:          &go_sdk.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	})
+
+	if _, err := client.Analyze(context.Background(), "عالی بود"); err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	ended := spans.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected one span, got %d", len(ended))
+	}
+	span := ended[0]
+	if span.Name() != "nlp.NLPManager/AnalyzeSentiment" {
+		t.Errorf("unexpected span name %q", span.Name())
+	}
+	attrs := attribute.NewSet(span.Attributes()...)
+	for key, want := range map[attribute.Key]attribute.Value{
+		"zennlp.text.length": attribute.IntValue(8),
+		"zennlp.lang":        attribute.StringValue("fa"),
+		"zennlp.label":       attribute.StringValue("positive"),
+		"zennlp.attempts":    attribute.IntValue(2),
+	} {
+		if got, ok := attrs.Value(key); !ok || got != want {
+			t.Errorf("attribute %s = %v, want %v", key, got.Emit(), want.Emit())
+		}
+	}
+	if n := len(span.Events()); n != 2 {
+		t.Errorf("expected an event per attempt, got %d", n)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect() error = %v", err)
+	}
+	got := map[string]bool{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			got[m.Name] = true
+		}
+	}
+	for _, name := range []string{"zennlp.client.duration", "zennlp.client.requests", "zennlp.client.labels"} {
+		if !got[name] {
+			t.Errorf("metric %s not recorded", name)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package tokenize
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Sentence is a span of the input text with its tokens
+type Sentence struct {
+	Text   string
+	Tokens []Token
+	// Start and End are the byte offsets of the sentence in the input, End exclusive
+	Start, End int
+	// RuneStart and RuneEnd are the same offsets counted in runes
+	RuneStart, RuneEnd int
+}
+
+// abbreviations are words followed by a full stop that does not end the
+// sentence. Single letters, as in initials and Persian abbreviations such as
+// ق.م, are always treated as abbreviations.
+var abbreviations = map[string]bool{
+	"mr": true, "mrs": true, "ms": true, "dr": true, "prof": true, "sr": true, "jr": true,
+	"st": true, "vs": true, "etc": true, "inc": true, "ltd": true, "approx": true,
+	"dept": true, "fig": true,
+}
+
+// closingMarks after a sentence-final mark still belong to the sentence
+const closingMarks = `»)"'”’]`
+
+// Final marks inside paired quotes and brackets do not end the sentence
+const (
+	openingPairs = "«(“["
+	closingPairs = "»)”]"
+)
+
+// SegmentSentences splits text into sentences. A sentence ends at a full stop,
+// question or exclamation mark, including Persian ؟, or at a line break. Full
+// stops after abbreviations and initials, and before a lower-case Latin word,
+// do not end a sentence, and neither do marks quoted within it, as in
+// «عالی بود!» گفت.
+func SegmentSentences(text string) []Sentence {
+	tokens := Tokenize(text)
+	var sentences []Sentence
+	start, depth := 0, 0
+	for i, tok := range tokens {
+		if tok.Kind == Punctuation {
+			depth += nesting(tok.Text)
+			depth = max(depth, 0)
+		}
+		if !endsSentence(text, tokens, i, depth) {
+			continue
+		}
+		sentences = append(sentences, newSentence(text, tokens[start:i+1]))
+		start, depth = i+1, 0
+	}
+	if start < len(tokens) {
+		sentences = append(sentences, newSentence(text, tokens[start:]))
+	}
+	return sentences
+}
+
+func newSentence(text string, tokens []Token) Sentence {
+	first, last := tokens[0], tokens[len(tokens)-1]
+	return Sentence{
+		Text:      text[first.Start:last.End],
+		Tokens:    tokens,
+		Start:     first.Start,
+		End:       last.End,
+		RuneStart: first.RuneStart,
+		RuneEnd:   last.RuneEnd,
+	}
+}
+
+// endsSentence reports whether the sentence ends after the token at i, with
+// depth quotes or brackets still open
+func endsSentence(text string, tokens []Token, i, depth int) bool {
+	if i+1 == len(tokens) {
+		return false
+	}
+	tok, next := tokens[i], tokens[i+1]
+	if strings.Contains(text[tok.End:next.Start], "\n") {
+		return true
+	}
+	if depth > 0 || strings.ContainsAny(tok.Text, closingPairs) {
+		return false
+	}
+
+	// The closing quote or bracket after the final mark ends the sentence instead
+	if isClosing(next) && i > 0 && isFinal(tokens[i]) {
+		return false
+	}
+	if isClosing(tok) && i > 0 && isFinal(tokens[i-1]) {
+		return true
+	}
+	if !isFinal(tok) {
+		return false
+	}
+
+	if tok.Text == "." && i > 0 {
+		prev := tokens[i-1]
+		if prev.End == tok.Start && prev.Kind == Word && isAbbreviation(prev.Text) {
+			return false
+		}
+		if r, _ := utf8.DecodeRuneInString(next.Text); unicode.IsLower(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// nesting returns how many quotes or brackets a punctuation token opens,
+// negative when it closes them
+func nesting(mark string) int {
+	n := 0
+	for _, r := range mark {
+		if strings.ContainsRune(openingPairs, r) {
+			n++
+		} else if strings.ContainsRune(closingPairs, r) {
+			n--
+		}
+	}
+	return n
+}
+
+func isFinal(tok Token) bool {
+	return tok.Kind == Punctuation && strings.ContainsAny(tok.Text, finalMarks)
+}
+
+func isClosing(tok Token) bool {
+	return tok.Kind == Punctuation && strings.ContainsAny(tok.Text, closingMarks)
+}
+
+func isAbbreviation(word string) bool {
+	return utf8.RuneCountInString(word) == 1 || abbreviations[strings.ToLower(word)]
+}
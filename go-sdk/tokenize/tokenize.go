@@ -0,0 +1,274 @@
+// Package tokenize splits Persian text into tokens and sentences in pure Go.
+// Words joined by a half-space (ZWNJ) stay a single token, and URLs, e-mail
+// addresses, hashtags, mentions, numbers and emoji are recognized as such.
+// Every token and sentence carries its byte and rune offsets in the input:
+//
+//	for _, tok := range tokenize.Tokenize("کتاب‌ها را دوست دارم 😍 #کتاب") {
+//		fmt.Println(tok.Kind, tok.Text, tok.Start, tok.End)
+//	}
+//
+// Text is best normalized first, see the normalize package.
+package tokenize
+
+import (
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const zwnj = '\u200c'
+
+// Kind classifies a token
+type Kind int
+
+const (
+	// Word is a run of letters of one script, including half-space joined compounds
+	Word Kind = iota
+	// Number is a run of digits of any script, with decimal and group separators
+	Number
+	// Punctuation is a punctuation mark, or a run of the same or of sentence-final marks
+	Punctuation
+	// Symbol is any other single character, such as % or +
+	Symbol
+	// Emoji is a single emoji, including modifiers, flags and joined sequences
+	Emoji
+	// URL is a web address starting with http://, https:// or www.
+	URL
+	// Email is an e-mail address
+	Email
+	// Hashtag is a word prefixed with #, which may contain underscores
+	Hashtag
+	// Mention is a user name prefixed with @
+	Mention
+)
+
+var kindNames = map[Kind]string{
+	Word:        "word",
+	Number:      "number",
+	Punctuation: "punctuation",
+	Symbol:      "symbol",
+	Emoji:       "emoji",
+	URL:         "url",
+	Email:       "email",
+	Hashtag:     "hashtag",
+	Mention:     "mention",
+}
+
+// String returns the lower-case name of the kind
+func (k Kind) String() string {
+	return kindNames[k]
+}
+
+// Token is a span of the input text
+type Token struct {
+	Text string
+	Kind Kind
+	// Start and End are the byte offsets of the token in the input, End exclusive
+	Start, End int
+	// RuneStart and RuneEnd are the same offsets counted in runes
+	RuneStart, RuneEnd int
+}
+
+var (
+	urlPattern   = regexp.MustCompile(`^(?i:https?://|www\.)[^\s«»"<>]+`)
+	emailPattern = regexp.MustCompile(`^[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`)
+)
+
+// finalMarks end a sentence and are grouped into one token, as in "?!" or "..."
+const finalMarks = ".!?؟…"
+
+// Tokenize splits text into tokens. Whitespace and invisible characters
+// between tokens are skipped.
+func Tokenize(text string) []Token {
+	var tokens []Token
+	runes := 0
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if unicode.IsSpace(r) || unicode.Is(unicode.Cf, r) {
+			i += size
+			runes++
+			continue
+		}
+
+		end, kind := scan(text, i)
+		n := utf8.RuneCountInString(text[i:end])
+		tokens = append(tokens, Token{
+			Text:      text[i:end],
+			Kind:      kind,
+			Start:     i,
+			End:       end,
+			RuneStart: runes,
+			RuneEnd:   runes + n,
+		})
+		i = end
+		runes += n
+	}
+	return tokens
+}
+
+// scan returns the end and kind of the token starting at byte i
+func scan(text string, i int) (int, Kind) {
+	rest := text[i:]
+	r, size := utf8.DecodeRuneInString(rest)
+
+	if r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+		if m := urlPattern.FindString(rest); m != "" {
+			return i + len(strings.TrimRight(m, `.,;:!?)]}'"،؛؟`)), URL
+		}
+		if m := emailPattern.FindString(rest); m != "" {
+			return i + len(m), Email
+		}
+	}
+
+	switch {
+	case r == '#' && startsWith(rest[size:], isTagRune):
+		return i + size + spanTag(rest[size:]), Hashtag
+	case r == '@' && startsWith(rest[size:], isMentionRune):
+		return i + size + span(rest[size:], isMentionRune), Mention
+	case unicode.IsDigit(r):
+		return i + spanNumber(rest), Number
+	case unicode.IsLetter(r):
+		return i + spanWord(rest), Word
+	case isEmoji(r):
+		return i + spanEmoji(rest), Emoji
+	case strings.ContainsRune(finalMarks, r):
+		return i + span(rest, func(r rune) bool { return strings.ContainsRune(finalMarks, r) }), Punctuation
+	case unicode.IsPunct(r):
+		return i + span(rest, func(next rune) bool { return next == r }), Punctuation
+	}
+	return i + size, Symbol
+}
+
+// span returns the length of the prefix of s whose runes satisfy fn
+func span(s string, fn func(rune) bool) int {
+	for i, r := range s {
+		if !fn(r) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+func startsWith(s string, fn func(rune) bool) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return s != "" && fn(r)
+}
+
+// script groups letters, so that adjacent Persian and Latin words are split
+func script(r rune) int {
+	switch {
+	case unicode.Is(unicode.Arabic, r):
+		return 1
+	case unicode.Is(unicode.Latin, r):
+		return 2
+	}
+	return 3
+}
+
+// spanWord returns the length of the word at the start of s: letters and marks
+// of one script, joined by half-spaces and, in Latin words, apostrophes
+func spanWord(s string) int {
+	first, _ := utf8.DecodeRuneInString(s)
+	sc := script(first)
+	end := 0
+	for i, r := range s {
+		switch {
+		case unicode.IsLetter(r) && script(r) == sc, unicode.Is(unicode.Mn, r) && i > 0:
+			end = i + utf8.RuneLen(r)
+		case (r == zwnj || (sc == 2 && (r == '\'' || r == '’'))) && i == end:
+			// Joins only when a letter of the same script follows
+			next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):])
+			if !unicode.IsLetter(next) || script(next) != sc {
+				return end
+			}
+		default:
+			return end
+		}
+	}
+	return end
+}
+
+// numberSeparators may appear between digits of a number, as in 3.5, 1,000,
+// ۱۴۰۲/۰۵/۱۲ or 10:30
+const numberSeparators = ".,/:٫٬"
+
+func spanNumber(s string) int {
+	end := 0
+	for i, r := range s {
+		switch {
+		case unicode.IsDigit(r):
+			end = i + utf8.RuneLen(r)
+		case strings.ContainsRune(numberSeparators, r) && i == end:
+			next, _ := utf8.DecodeRuneInString(s[i+utf8.RuneLen(r):])
+			if !unicode.IsDigit(next) {
+				return end
+			}
+		default:
+			return end
+		}
+	}
+	return end
+}
+
+func isTagRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.Is(unicode.Mn, r) || r == '_' || r == zwnj
+}
+
+func spanTag(s string) int {
+	n := span(s, isTagRune)
+	return len(strings.TrimRight(s[:n], string(zwnj)))
+}
+
+func isMentionRune(r rune) bool {
+	return r < utf8.RuneSelf && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_')
+}
+
+// isEmoji reports pictographs and regional indicators
+func isEmoji(r rune) bool {
+	switch {
+	case r >= 0x1F000 && r <= 0x1FAFF:
+		return true
+	case r >= 0x2600 && r <= 0x27BF, r >= 0x2B00 && r <= 0x2BFF:
+		return true
+	}
+	return false
+}
+
+// isEmojiModifier reports skin tones, variation selectors and the keycap mark
+func isEmojiModifier(r rune) bool {
+	return (r >= 0x1F3FB && r <= 0x1F3FF) || r == 0xFE0F || r == 0xFE0E || r == 0x20E3
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
+
+// spanEmoji returns the length of the emoji at the start of s with its
+// modifiers, a pair of regional indicators forming a flag, or a sequence
+// joined by zero-width joiners
+func spanEmoji(s string) int {
+	first, end := utf8.DecodeRuneInString(s)
+	if isRegionalIndicator(first) {
+		if next, size := utf8.DecodeRuneInString(s[end:]); isRegionalIndicator(next) {
+			return end + size
+		}
+		return end
+	}
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		switch {
+		case isEmojiModifier(r):
+			end += size
+		case r == '\u200d':
+			next, nextSize := utf8.DecodeRuneInString(s[end+size:])
+			if !isEmoji(next) {
+				return end
+			}
+			end += size + nextSize
+		default:
+			return end
+		}
+	}
+	return end
+}
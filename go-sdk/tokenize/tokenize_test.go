@@ -0,0 +1,111 @@
+package tokenize_test
+
+import (
+	"testing"
+	"unicode/utf8"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+)
+
+func TestTokenize(t *testing.T) {
+	type tok struct {
+		text string
+		kind tokenize.Kind
+	}
+	tests := []struct {
+		name string
+		in   string
+		want []tok
+	}{
+		{"half-space compounds", "کتاب‌ها را نمی‌خواهم", []tok{
+			{"کتاب‌ها", tokenize.Word}, {"را", tokenize.Word}, {"نمی‌خواهم", tokenize.Word},
+		}},
+		{"persian punctuation", "«غذا» سرد بود، ولی خوب؛ چرا؟", []tok{
+			{"«", tokenize.Punctuation}, {"غذا", tokenize.Word}, {"»", tokenize.Punctuation},
+			{"سرد", tokenize.Word}, {"بود", tokenize.Word}, {"،", tokenize.Punctuation},
+			{"ولی", tokenize.Word}, {"خوب", tokenize.Word}, {"؛", tokenize.Punctuation},
+			{"چرا", tokenize.Word}, {"؟", tokenize.Punctuation},
+		}},
+		{"mixed script and numbers", "گوشیiPhone ۱۳ به قیمت 12,500 تومان و ۳٫۵ درصد!!", []tok{
+			{"گوشی", tokenize.Word}, {"iPhone", tokenize.Word}, {"۱۳", tokenize.Number},
+			{"به", tokenize.Word}, {"قیمت", tokenize.Word}, {"12,500", tokenize.Number},
+			{"تومان", tokenize.Word}, {"و", tokenize.Word}, {"۳٫۵", tokenize.Number},
+			{"درصد", tokenize.Word}, {"!!", tokenize.Punctuation},
+		}},
+		{"web", "سفارش از https://example.com/a?b=1، ایمیل info@example.ir یا @zen_nlp", []tok{
+			{"سفارش", tokenize.Word}, {"از", tokenize.Word}, {"https://example.com/a?b=1", tokenize.URL},
+			{"،", tokenize.Punctuation}, {"ایمیل", tokenize.Word}, {"info@example.ir", tokenize.Email},
+			{"یا", tokenize.Word}, {"@zen_nlp", tokenize.Mention},
+		}},
+		{"emoji and hashtags", "عالی😍👍🏽 #غذای_خوب #پیتزا‌ 🇮🇷", []tok{
+			{"عالی", tokenize.Word}, {"😍", tokenize.Emoji}, {"👍🏽", tokenize.Emoji},
+			{"#غذای_خوب", tokenize.Hashtag}, {"#پیتزا", tokenize.Hashtag}, {"🇮🇷", tokenize.Emoji},
+		}},
+		{"symbols", "۵۰٪ تخفیف + ارسال", []tok{
+			{"۵۰", tokenize.Number}, {"٪", tokenize.Punctuation}, {"تخفیف", tokenize.Word},
+			{"+", tokenize.Symbol}, {"ارسال", tokenize.Word},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenize.Tokenize(tt.in)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Tokenize(%q) = %v, want %d tokens", tt.in, got, len(tt.want))
+			}
+			for i, w := range tt.want {
+				if got[i].Text != w.text || got[i].Kind != w.kind {
+					t.Errorf("token %d = %q (%s), want %q (%s)", i, got[i].Text, got[i].Kind, w.text, w.kind)
+				}
+			}
+		})
+	}
+}
+
+func TestTokenOffsets(t *testing.T) {
+	text := " سلام،  دنیا 👋 hello "
+	for _, tok := range tokenize.Tokenize(text) {
+		if text[tok.Start:tok.End] != tok.Text {
+			t.Errorf("byte offsets of %q select %q", tok.Text, text[tok.Start:tok.End])
+		}
+		if n := utf8.RuneCountInString(text[:tok.Start]); n != tok.RuneStart {
+			t.Errorf("RuneStart of %q = %d, want %d", tok.Text, tok.RuneStart, n)
+		}
+		if n := tok.RuneStart + utf8.RuneCountInString(tok.Text); n != tok.RuneEnd {
+			t.Errorf("RuneEnd of %q = %d, want %d", tok.Text, tok.RuneEnd, n)
+		}
+	}
+}
+
+func TestSegmentSentences(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want []string
+	}{
+		{"persian marks", "غذا عالی بود. ارسال دیر شد! دوباره سفارش می‌دهم؟ بله", []string{
+			"غذا عالی بود.", "ارسال دیر شد!", "دوباره سفارش می‌دهم؟", "بله",
+		}},
+		{"quotes", "گفت «عالی بود!» و رفت. تمام", []string{"گفت «عالی بود!» و رفت.", "تمام"}},
+		{"abbreviations", "Dr. Smith met J. Doe at 3.5 p.m. today. Great", []string{"Dr. Smith met J. Doe at 3.5 p.m. today.", "Great"}},
+		{"persian abbreviations", "سال ۵۰۰ ق.م. ساخته شد. زیباست", []string{"سال ۵۰۰ ق.م. ساخته شد.", "زیباست"}},
+		{"line breaks", "خوب\nبد", []string{"خوب", "بد"}},
+		{"repeated marks", "واقعا؟!! نه...", []string{"واقعا؟!!", "نه..."}},
+		{"url", "سایت www.example.com است. بله", []string{"سایت www.example.com است.", "بله"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenize.SegmentSentences(tt.in)
+			if len(got) != len(tt.want) {
+				t.Fatalf("SegmentSentences(%q) returned %d sentences, want %d: %+v", tt.in, len(got), len(tt.want), got)
+			}
+			for i, w := range tt.want {
+				if got[i].Text != w {
+					t.Errorf("sentence %d = %q, want %q", i, got[i].Text, w)
+				}
+				if tt.in[got[i].Start:got[i].End] != got[i].Text {
+					t.Errorf("offsets of sentence %d select %q", i, tt.in[got[i].Start:got[i].End])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package go_sdk
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+)
+
+// Token is a span of a text as segmented by the server
+type Token struct {
+	Text string
+	Kind tokenize.Kind
+	// Start and End are the offsets of the token in the text in runes, End exclusive
+	Start, End int
+}
+
+// Sentence is a sentence of a text with its tokens
+type Sentence struct {
+	Text   string
+	Tokens []Token
+	// Start and End are the offsets of the sentence in the text in runes, End exclusive
+	Start, End int
+}
+
+// Tokenize splits text into tokens the same way the server does, so that
+// model outputs can be aligned with the text. Whitespace is left out.
+func (c *Client) Tokenize(ctx context.Context, text string) ([]Token, error) {
+	resp, err := c.client.Tokenize(ctx, &pb.TokenizeRequest{
+		Text: text,
+		Lang: "fa",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tokenization failed: %w", err)
+	}
+	return newTokens(resp.Tokens), nil
+}
+
+// Sentences splits text into sentences the same way the server does
+func (c *Client) Sentences(ctx context.Context, text string) ([]Sentence, error) {
+	resp, err := c.client.SegmentSentences(ctx, &pb.SegmentSentencesRequest{
+		Text: text,
+		Lang: "fa",
+	})
+	if err != nil {
+		return nil, fmt.Errorf("sentence segmentation failed: %w", err)
+	}
+
+	sentences := make([]Sentence, len(resp.Sentences))
+	for i, s := range resp.Sentences {
+		sentences[i] = Sentence{
+			Text:   s.Text,
+			Tokens: newTokens(s.Tokens),
+			Start:  int(s.Start),
+			End:    int(s.End),
+		}
+	}
+	return sentences, nil
+}
+
+var tokenKinds = map[pb.TokenKind]tokenize.Kind{
+	pb.TokenKind_TOKEN_KIND_WORD:        tokenize.Word,
+	pb.TokenKind_TOKEN_KIND_NUMBER:      tokenize.Number,
+	pb.TokenKind_TOKEN_KIND_PUNCTUATION: tokenize.Punctuation,
+	pb.TokenKind_TOKEN_KIND_SYMBOL:      tokenize.Symbol,
+	pb.TokenKind_TOKEN_KIND_EMOJI:       tokenize.Emoji,
+	pb.TokenKind_TOKEN_KIND_URL:         tokenize.URL,
+	pb.TokenKind_TOKEN_KIND_EMAIL:       tokenize.Email,
+	pb.TokenKind_TOKEN_KIND_HASHTAG:     tokenize.Hashtag,
+	pb.TokenKind_TOKEN_KIND_MENTION:     tokenize.Mention,
+}
+
+// newTokens converts wire tokens. Kinds the SDK does not know are reported as
+// tokenize.Symbol.
+func newTokens(toks []*pb.Token) []Token {
+	out := make([]Token, len(toks))
+	for i, tok := range toks {
+		kind, ok := tokenKinds[tok.Kind]
+		if !ok {
+			kind = tokenize.Symbol
+		}
+		out[i] = Token{
+			Text:  tok.Text,
+			Kind:  kind,
+			Start: int(tok.Start),
+			End:   int(tok.End),
+		}
+	}
+	return out
+}
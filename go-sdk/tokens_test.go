@@ -0,0 +1,54 @@
+package go_sdk_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Mannymz/ZenNLP/go-sdk/tokenize"
+	"github.com/Mannymz/ZenNLP/go-sdk/zennlptest"
+)
+
+func TestTokenizeMatchesLocalTokenizer(t *testing.T) {
+	client := zennlptest.NewServer(t).Client(t)
+
+	text := "سفارش #۱۲۳ دیر رسید 😞 ولی پیک مودب بود. ممنون!"
+	tokens, err := client.Tokenize(context.Background(), text)
+	if err != nil {
+		t.Fatalf("Tokenize() error = %v", err)
+	}
+
+	local := tokenize.Tokenize(text)
+	if len(tokens) != len(local) {
+		t.Fatalf("Tokenize() returned %d tokens, want %d", len(tokens), len(local))
+	}
+	for i, tok := range tokens {
+		want := local[i]
+		if tok.Text != want.Text || tok.Kind != want.Kind || tok.Start != want.RuneStart || tok.End != want.RuneEnd {
+			t.Errorf("token %d = %+v, want %+v", i, tok, want)
+		}
+	}
+}
+
+func TestSentences(t *testing.T) {
+	client := zennlptest.NewServer(t).Client(t)
+
+	text := "غذا سرد بود. ارسال سریع بود؟ بله"
+	sentences, err := client.Sentences(context.Background(), text)
+	if err != nil {
+		t.Fatalf("Sentences() error = %v", err)
+	}
+
+	want := []string{"غذا سرد بود.", "ارسال سریع بود؟", "بله"}
+	if len(sentences) != len(want) {
+		t.Fatalf("Sentences() returned %d sentences, want %d", len(sentences), len(want))
+	}
+	runes := []rune(text)
+	for i, s := range sentences {
+		if s.Text != want[i] || string(runes[s.Start:s.End]) != want[i] {
+			t.Errorf("sentence %d = %q at [%d, %d), want %q", i, s.Text, s.Start, s.End, want[i])
+		}
+		if first := s.Tokens[0]; first.Start != s.Start {
+			t.Errorf("sentence %d starts at %d, its first token at %d", i, s.Start, first.Start)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package zennlptest
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"google.golang.org/grpc/codes"
+)
+
+// Rule scripts how the server answers matching requests. Without Return or
+// Fail, a rule only adds latency and the lexicon scorer produces the response.
+type Rule struct {
+	srv   *Server
+	text  string
+	match bool
+
+	resp    *pb.SentimentResponse
+	code    codes.Code
+	message string
+	delay   time.Duration
+	hook    func(ctx context.Context)
+	abort   bool
+
+	limited   bool
+	remaining int
+}
+
+// Return answers with the given label and score
+func (r *Rule) Return(label string, score float64) *Rule {
+	return r.ReturnResponse(&pb.SentimentResponse{
+		Label:         label,
+		Score:         score,
+		Probabilities: map[string]float64{label: score},
+	})
+}
+
+// ReturnResponse answers with the given response
+func (r *Rule) ReturnResponse(resp *pb.SentimentResponse) *Rule {
+	r.srv.mu.Lock()
+	defer r.srv.mu.Unlock()
+	r.resp = resp
+	r.code = codes.OK
+	return r
+}
+
+// Fail answers with the given gRPC status
+func (r *Rule) Fail(code codes.Code, message string) *Rule {
+	r.srv.mu.Lock()
+	defer r.srv.mu.Unlock()
+	r.code = code
+	r.message = message
+	return r
+}
+
+// AbortStream makes Fail end a whole StreamSentiment call with its status,
+// as a crashing engine would, instead of answering the message with an error
+func (r *Rule) AbortStream() *Rule {
+	r.srv.mu.Lock()
+	defer r.srv.mu.Unlock()
+	r.abort = true
+	return r
+}
+
+// After delays the answer by d, or until the request is cancelled
+func (r *Rule) After(d time.Duration) *Rule {
+	r.srv.mu.Lock()
+	defer r.srv.mu.Unlock()
+	r.delay = d
+	return r
+}
+
+// Do calls fn with the request context before answering, e.g. to signal that
+// the request arrived and block it until the test releases it
+func (r *Rule) Do(fn func(ctx context.Context)) *Rule {
+	r.srv.mu.Lock()
+	defer r.srv.mu.Unlock()
+	r.hook = fn
+	return r
+}
+
+// Times limits the rule to the next n matching requests, after which later
+// rules or the lexicon scorer take over
+func (r *Rule) Times(n int) *Rule {
+	r.srv.mu.Lock()
+	defer r.srv.mu.Unlock()
+	r.limited = true
+	r.remaining = n
+	return r
+}
@@ -0,0 +1,366 @@
+// Package zennlptest provides an in-memory NLPManager server for testing code
+// that uses the Go SDK, without a running engine or open ports.
+//
+// Responses can be scripted per input text, including latencies and gRPC error
+// codes. Inputs without a matching rule are scored by the Go lexicon server.
+//
+//	srv := zennlptest.NewServer(t)
+//	srv.On("سلام").Fail(codes.Unavailable, "engine overloaded").Times(2)
+//	client := srv.Client(t)
+package zennlptest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	go_sdk "github.com/Mannymz/ZenNLP/go-sdk"
+	pb "github.com/Mannymz/ZenNLP/go-sdk/api"
+	"github.com/Mannymz/ZenNLP/go-sdk/server"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+const bufSize = 1 << 20
+
+// Request is a request received by the fake server. Batch and stream items are
+// recorded individually, with Method set to the RPC they arrived on.
+type Request struct {
+	Method   string
+	Text     string
+	Lang     string
+	Metadata metadata.MD
+}
+
+// Server is an in-memory NLPManager server
+type Server struct {
+	pb.UnimplementedNLPManagerServer
+
+	lis      *bufconn.Listener
+	grpc     *grpc.Server
+	fallback *server.Server
+	health   *health.Server
+
+	mu       sync.Mutex
+	rules    []*Rule
+	requests []Request
+}
+
+// NewServer starts an in-memory server that is stopped when the test ends
+func NewServer(t testing.TB) *Server {
+	t.Helper()
+
+	s := &Server{
+		lis:      bufconn.Listen(bufSize),
+		grpc:     grpc.NewServer(),
+		fallback: server.New(),
+		health:   health.NewServer(),
+	}
+	pb.RegisterNLPManagerServer(s.grpc, s)
+	healthpb.RegisterHealthServer(s.grpc, s.health)
+	server.SetServing(s.health, true)
+
+	go func() {
+		if err := s.grpc.Serve(s.lis); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			t.Errorf("zennlptest: server failed: %v", err)
+		}
+	}()
+	t.Cleanup(s.grpc.Stop)
+
+	return s
+}
+
+// DialOption returns the dial option connecting to the in-memory server
+func (s *Server) DialOption() grpc.DialOption {
+	return grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return s.Dial(ctx)
+	})
+}
+
+// Dial opens a connection to the in-memory server, for dialers that route
+// between several servers
+func (s *Server) Dial(ctx context.Context) (net.Conn, error) {
+	return s.lis.DialContext(ctx)
+}
+
+// Client returns an SDK client connected to the server with fast retries
+func (s *Server) Client(t testing.TB) *go_sdk.Client {
+	t.Helper()
+	return s.ClientWithConfig(t, go_sdk.Config{
+		Timeout: 5 * time.Second,
+		Retry: &go_sdk.RetryPolicy{
+			MaxAttempts:       4,
+			InitialBackoff:    time.Millisecond,
+			MaxBackoff:        10 * time.Millisecond,
+			BackoffMultiplier: 2,
+		},
+	})
+}
+
+// ClientWithConfig returns an SDK client connected to the server. The address
+// of cfg is ignored, and the connection is insecure unless TLS is configured.
+func (s *Server) ClientWithConfig(t testing.TB, cfg go_sdk.Config) *go_sdk.Client {
+	t.Helper()
+
+	cfg.Address = "passthrough:///bufnet"
+	if cfg.CAFile == "" && cfg.CertFile == "" && cfg.ServerName == "" && cfg.TLSConfig == nil {
+		cfg.Insecure = true
+	}
+	cfg.DialOptions = append(cfg.DialOptions, s.DialOption())
+
+	client, err := go_sdk.NewClientWithConfig(cfg)
+	if err != nil {
+		t.Fatalf("zennlptest: failed to create client: %v", err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// SetServing sets the health status the server reports, SERVING by default
+func (s *Server) SetServing(serving bool) {
+	server.SetServing(s.health, serving)
+}
+
+// On adds a rule for requests with exactly the given text. Rules are matched in
+// the order they were added.
+func (s *Server) On(text string) *Rule {
+	return s.addRule(&Rule{text: text, match: true})
+}
+
+// OnAny adds a rule matching every request
+func (s *Server) OnAny() *Rule {
+	return s.addRule(&Rule{})
+}
+
+func (s *Server) addRule(r *Rule) *Rule {
+	r.srv = s
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules = append(s.rules, r)
+	return r
+}
+
+// Requests returns every request received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Count returns how many requests with the given text were received
+func (s *Server) Count(text string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := 0
+	for _, r := range s.requests {
+		if r.Text == text {
+			n++
+		}
+	}
+	return n
+}
+
+// Reset forgets all rules and recorded requests
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rules = nil
+	s.requests = nil
+}
+
+// AnalyzeSentiment implements pb.NLPManagerServer
+func (s *Server) AnalyzeSentiment(ctx context.Context, req *pb.SentimentRequest) (*pb.SentimentResponse, error) {
+	return s.handle(ctx, pb.NLPManager_AnalyzeSentiment_FullMethodName, req)
+}
+
+// AnalyzeSentimentBatch implements pb.NLPManagerServer. Rules apply to each item.
+func (s *Server) AnalyzeSentimentBatch(ctx context.Context, req *pb.SentimentBatchRequest) (*pb.SentimentBatchResponse, error) {
+	results := make([]*pb.SentimentBatchResult, len(req.Items))
+	for i, item := range req.Items {
+		result := &pb.SentimentBatchResult{Index: int32(i)}
+		resp, err := s.handle(ctx, pb.NLPManager_AnalyzeSentimentBatch_FullMethodName, item)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, err
+			}
+			result.Outcome = &pb.SentimentBatchResult_Error{Error: itemError(err)}
+		} else {
+			result.Outcome = &pb.SentimentBatchResult_Response{Response: resp}
+		}
+		results[i] = result
+	}
+	return &pb.SentimentBatchResponse{Results: results}, nil
+}
+
+// StreamSentiment implements pb.NLPManagerServer. Rules apply to each message.
+func (s *Server) StreamSentiment(stream pb.NLPManager_StreamSentimentServer) error {
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		resp := &pb.SentimentStreamResponse{Id: req.Id}
+		result, err := s.handle(stream.Context(), pb.NLPManager_StreamSentiment_FullMethodName, req.Request)
+		if abort, ok := err.(streamAbort); ok {
+			return abort.err
+		}
+		if err != nil {
+			if stream.Context().Err() != nil {
+				return err
+			}
+			resp.Outcome = &pb.SentimentStreamResponse_Error{Error: itemError(err)}
+		} else {
+			resp.Outcome = &pb.SentimentStreamResponse_Response{Response: result}
+		}
+
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+	}
+}
+
+// Lemmatize implements pb.NLPManagerServer. Requests are recorded and answered
+// by the Go server; rules do not apply.
+func (s *Server) Lemmatize(ctx context.Context, req *pb.LemmatizeRequest) (*pb.LemmatizeResponse, error) {
+	s.record(ctx, pb.NLPManager_Lemmatize_FullMethodName, req.Text, req.Lang)
+	return s.fallback.Lemmatize(ctx, req)
+}
+
+// Tokenize implements pb.NLPManagerServer. Requests are recorded and answered
+// by the Go server; rules do not apply.
+func (s *Server) Tokenize(ctx context.Context, req *pb.TokenizeRequest) (*pb.TokenizeResponse, error) {
+	s.record(ctx, pb.NLPManager_Tokenize_FullMethodName, req.Text, req.Lang)
+	return s.fallback.Tokenize(ctx, req)
+}
+
+// SegmentSentences implements pb.NLPManagerServer. Requests are recorded and
+// answered by the Go server; rules do not apply.
+func (s *Server) SegmentSentences(ctx context.Context, req *pb.SegmentSentencesRequest) (*pb.SegmentSentencesResponse, error) {
+	s.record(ctx, pb.NLPManager_SegmentSentences_FullMethodName, req.Text, req.Lang)
+	return s.fallback.SegmentSentences(ctx, req)
+}
+
+// ExtractEntities implements pb.NLPManagerServer. Requests are recorded and
+// answered by the Go server; rules do not apply.
+func (s *Server) ExtractEntities(ctx context.Context, req *pb.ExtractEntitiesRequest) (*pb.ExtractEntitiesResponse, error) {
+	s.record(ctx, pb.NLPManager_ExtractEntities_FullMethodName, req.Text, req.Lang)
+	return s.fallback.ExtractEntities(ctx, req)
+}
+
+// TagPartsOfSpeech implements pb.NLPManagerServer. Requests are recorded and
+// answered by the Go server; rules do not apply.
+func (s *Server) TagPartsOfSpeech(ctx context.Context, req *pb.TagPartsOfSpeechRequest) (*pb.TagPartsOfSpeechResponse, error) {
+	s.record(ctx, pb.NLPManager_TagPartsOfSpeech_FullMethodName, req.Text, req.Lang)
+	return s.fallback.TagPartsOfSpeech(ctx, req)
+}
+
+// AnalyzeAspects implements pb.NLPManagerServer. Requests are recorded and
+// answered by the Go server; rules do not apply.
+func (s *Server) AnalyzeAspects(ctx context.Context, req *pb.AnalyzeAspectsRequest) (*pb.AnalyzeAspectsResponse, error) {
+	s.record(ctx, pb.NLPManager_AnalyzeAspects_FullMethodName, req.Text, req.Lang)
+	return s.fallback.AnalyzeAspects(ctx, req)
+}
+
+func (s *Server) record(ctx context.Context, method, text, lang string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recordLocked(ctx, method, text, lang)
+}
+
+func (s *Server) recordLocked(ctx context.Context, method, text, lang string) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.requests = append(s.requests, Request{Method: method, Text: text, Lang: lang, Metadata: md})
+}
+
+// handle records a request and answers it from the first matching rule
+func (s *Server) handle(ctx context.Context, method string, req *pb.SentimentRequest) (*pb.SentimentResponse, error) {
+	if req == nil {
+		req = &pb.SentimentRequest{}
+	}
+
+	s.mu.Lock()
+	s.recordLocked(ctx, method, req.Text, req.Lang)
+	matched := s.matchLocked(req.Text)
+	var rule Rule
+	if matched != nil {
+		rule = *matched
+	}
+	s.mu.Unlock()
+
+	if matched == nil {
+		return s.fallback.AnalyzeSentiment(ctx, req)
+	}
+
+	if rule.delay > 0 {
+		timer := time.NewTimer(rule.delay)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	if rule.hook != nil {
+		rule.hook(ctx)
+	}
+
+	if rule.code != codes.OK {
+		err := status.Error(rule.code, rule.message)
+		if rule.abort && method == pb.NLPManager_StreamSentiment_FullMethodName {
+			return nil, streamAbort{err}
+		}
+		return nil, err
+	}
+	if rule.resp != nil {
+		return rule.resp, nil
+	}
+	return s.fallback.AnalyzeSentiment(ctx, req)
+}
+
+// streamAbort carries the status a rule ends a stream with
+type streamAbort struct {
+	err error
+}
+
+func (e streamAbort) Error() string {
+	return e.err.Error()
+}
+
+// matchLocked finds the first rule that applies to text and consumes one use of it
+func (s *Server) matchLocked(text string) *Rule {
+	for _, r := range s.rules {
+		if r.match && r.text != text {
+			continue
+		}
+		if r.limited && r.remaining == 0 {
+			continue
+		}
+		if r.limited {
+			r.remaining--
+		}
+		return r
+	}
+	return nil
+}
+
+func itemError(err error) *pb.ItemError {
+	st := status.Convert(err)
+	return &pb.ItemError{
+		Code:    int32(st.Code()),
+		Message: st.Message(),
+	}
+}
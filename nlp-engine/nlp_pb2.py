@@ -24,7 +24,7 @@ _sym_db = _symbol_database.Default()
 
 
 
-DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\tnlp.proto\x12\x03nlp\".\n\x10SentimentRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"1\n\x11SentimentResponse\x12\r\n\x05label\x18\x01 \x01(\t\x12\r\n\x05score\x18\x02 \x01(\x01\x32O\n\nNLPManager\x12\x41\n\x10\x41nalyzeSentiment\x12\x15.nlp.SentimentRequest\x1a\x16.nlp.SentimentResponseB\x1fZ\x1dgithub.com/Mannymz/ZenNLP/apib\x06proto3')
+DESCRIPTOR = _descriptor_pool.Default().AddSerializedFile(b'\n\tnlp.proto\x12\x03nlp\".\n\x10SentimentRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"\xe8\x01\n\x11SentimentResponse\x12\r\n\x05label\x18\x01 \x01(\t\x12\r\n\x05score\x18\x02 \x01(\x01\x12&\n\tsentiment\x18\x03 \x01(\x0e\x32\x13.nlp.SentimentLabel\x12@\n\rprobabilities\x18\x04 \x03(\x0b\x32).nlp.SentimentResponse.ProbabilitiesEntry\x12\x15\n\rmodel_version\x18\x05 \x01(\t\x1a\x34\n\x12ProbabilitiesEntry\x12\x0b\n\x03key\x18\x01 \x01(\t\x12\r\n\x05value\x18\x02 \x01(\x01:\x02\x38\x01\"=\n\x15SentimentBatchRequest\x12$\n\x05items\x18\x01 \x03(\x0b\x32\x15.nlp.SentimentRequest\"D\n\x16SentimentBatchResponse\x12*\n\x07results\x18\x01 \x03(\x0b\x32\x19.nlp.SentimentBatchResult\"}\n\x14SentimentBatchResult\x12\r\n\x05index\x18\x01 \x01(\x05\x12*\n\x08response\x18\x02 \x01(\x0b\x32\x16.nlp.SentimentResponseH\x00\x12\x1f\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x0e.nlp.ItemErrorH\x00\x42\t\n\x07outcome\"*\n\tItemError\x12\x0c\n\x04\x63ode\x18\x01 \x01(\x05\x12\x0f\n\x07message\x18\x02 \x01(\t\"L\n\x16SentimentStreamRequest\x12\n\n\x02id\x18\x01 \x01(\t\x12&\n\x07request\x18\x02 \x01(\x0b\x32\x15.nlp.SentimentRequest\"}\n\x17SentimentStreamResponse\x12\n\n\x02id\x18\x01 \x01(\t\x12*\n\x08response\x18\x02 \x01(\x0b\x32\x16.nlp.SentimentResponseH\x00\x12\x1f\n\x05\x65rror\x18\x03 \x01(\x0b\x32\x0e.nlp.ItemErrorH\x00\x42\t\n\x07outcome\".\n\x10LemmatizeRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"N\n\x05Lemma\x12\x0c\n\x04word\x18\x01 \x01(\t\x12\x0c\n\x04stem\x18\x02 \x01(\t\x12\r\n\x05lemma\x18\x03 \x01(\t\x12\r\n\x05start\x18\x04 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x05 \x01(\x05\"/\n\x11LemmatizeResponse\x12\x1a\n\x06lemmas\x18\x01 \x03(\x0b\x32\n.nlp.Lemma\"-\n\x0fTokenizeRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"O\n\x05Token\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x1c\n\x04kind\x18\x02 \x01(\x0e\x32\x0e.nlp.TokenKind\x12\r\n\x05start\x18\x03 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x05\".\n\x10TokenizeResponse\x12\x1a\n\x06tokens\x18\x01 \x03(\x0b\x32\n.nlp.Token\"5\n\x17SegmentSentencesRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"P\n\x08Sentence\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x1a\n\x06tokens\x18\x02 \x03(\x0b\x32\n.nlp.Token\x12\r\n\x05start\x18\x03 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x05\"<\n\x18SegmentSentencesResponse\x12 \n\tsentences\x18\x01 \x03(\x0b\x32\r.nlp.Sentence\"4\n\x16\x45xtractEntitiesRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"e\n\x06\x45ntity\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x1d\n\x04type\x18\x02 \x01(\x0e\x32\x0f.nlp.EntityType\x12\r\n\x05start\x18\x03 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x05\x12\x12\n\nconfidence\x18\x05 \x01(\x01\"8\n\x17\x45xtractEntitiesResponse\x12\x1d\n\x08\x65ntities\x18\x01 \x03(\x0b\x32\x0b.nlp.Entity\"5\n\x17TagPartsOfSpeechRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"f\n\x0bTaggedToken\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x1e\n\x03tag\x18\x02 \x01(\x0e\x32\x11.nlp.PartOfSpeech\x12\r\n\x05start\x18\x03 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x05\x12\r\n\x05lemma\x18\x05 \x01(\t\"<\n\x18TagPartsOfSpeechResponse\x12 \n\x06tokens\x18\x01 \x03(\x0b\x32\x10.nlp.TaggedToken\"3\n\x15\x41nalyzeAspectsRequest\x12\x0c\n\x04text\x18\x01 \x01(\t\x12\x0c\n\x04lang\x18\x02 \x01(\t\"w\n\x06\x41spect\x12\x0c\n\x04term\x18\x01 \x01(\t\x12\x10\n\x08\x63\x61tegory\x18\x02 \x01(\t\x12\r\n\x05start\x18\x03 \x01(\x05\x12\x0b\n\x03\x65nd\x18\x04 \x01(\x05\x12\"\n\x05label\x18\x05 \x01(\x0e\x32\x13.nlp.SentimentLabel\x12\r\n\x05score\x18\x06 \x01(\x01\"6\n\x16\x41nalyzeAspectsResponse\x12\x1c\n\x07\x61spects\x18\x01 \x03(\x0b\x32\x0b.nlp.Aspect*\xa5\x01\n\x0eSentimentLabel\x12\x1f\n\x1bSENTIMENT_LABEL_UNSPECIFIED\x10\x00\x12\x1c\n\x18SENTIMENT_LABEL_POSITIVE\x10\x01\x12\x1c\n\x18SENTIMENT_LABEL_NEGATIVE\x10\x02\x12\x1b\n\x17SENTIMENT_LABEL_NEUTRAL\x10\x03\x12\x19\n\x15SENTIMENT_LABEL_MIXED\x10\x04*\xf6\x01\n\tTokenKind\x12\x1a\n\x16TOKEN_KIND_UNSPECIFIED\x10\x00\x12\x13\n\x0fTOKEN_KIND_WORD\x10\x01\x12\x15\n\x11TOKEN_KIND_NUMBER\x10\x02\x12\x1a\n\x16TOKEN_KIND_PUNCTUATION\x10\x03\x12\x15\n\x11TOKEN_KIND_SYMBOL\x10\x04\x12\x14\n\x10TOKEN_KIND_EMOJI\x10\x05\x12\x12\n\x0eTOKEN_KIND_URL\x10\x06\x12\x14\n\x10TOKEN_KIND_EMAIL\x10\x07\x12\x16\n\x12TOKEN_KIND_HASHTAG\x10\x08\x12\x16\n\x12TOKEN_KIND_MENTION\x10\t*\xae\x01\n\nEntityType\x12\x1b\n\x17\x45NTITY_TYPE_UNSPECIFIED\x10\x00\x12\x13\n\x0f\x45NTITY_TYPE_PER\x10\x01\x12\x13\n\x0f\x45NTITY_TYPE_LOC\x10\x02\x12\x13\n\x0f\x45NTITY_TYPE_ORG\x10\x03\x12\x17\n\x13\x45NTITY_TYPE_PRODUCT\x10\x04\x12\x14\n\x10\x45NTITY_TYPE_DATE\x10\x05\x12\x15\n\x11\x45NTITY_TYPE_MONEY\x10\x06*\xd1\x03\n\x0cPartOfSpeech\x12\x1e\n\x1aPART_OF_SPEECH_UNSPECIFIED\x10\x00\x12\x16\n\x12PART_OF_SPEECH_ADJ\x10\x01\x12\x16\n\x12PART_OF_SPEECH_ADP\x10\x02\x12\x16\n\x12PART_OF_SPEECH_ADV\x10\x03\x12\x16\n\x12PART_OF_SPEECH_AUX\x10\x04\x12\x18\n\x14PART_OF_SPEECH_CCONJ\x10\x05\x12\x16\n\x12PART_OF_SPEECH_DET\x10\x06\x12\x17\n\x13PART_OF_SPEECH_INTJ\x10\x07\x12\x17\n\x13PART_OF_SPEECH_NOUN\x10\x08\x12\x16\n\x12PART_OF_SPEECH_NUM\x10\t\x12\x17\n\x13PART_OF_SPEECH_PART\x10\n\x12\x17\n\x13PART_OF_SPEECH_PRON\x10\x0b\x12\x18\n\x14PART_OF_SPEECH_PROPN\x10\x0c\x12\x18\n\x14PART_OF_SPEECH_PUNCT\x10\r\x12\x18\n\x14PART_OF_SPEECH_SCONJ\x10\x0e\x12\x16\n\x12PART_OF_SPEECH_SYM\x10\x0f\x12\x17\n\x13PART_OF_SPEECH_VERB\x10\x10\x12\x14\n\x10PART_OF_SPEECH_X\x10\x11\x32\xa3\x05\n\nNLPManager\x12\x41\n\x10\x41nalyzeSentiment\x12\x15.nlp.SentimentRequest\x1a\x16.nlp.SentimentResponse\x12P\n\x15\x41nalyzeSentimentBatch\x12\x1a.nlp.SentimentBatchRequest\x1a\x1b.nlp.SentimentBatchResponse\x12P\n\x0fStreamSentiment\x12\x1b.nlp.SentimentStreamRequest\x1a\x1c.nlp.SentimentStreamResponse(\x01\x30\x01\x12:\n\tLemmatize\x12\x15.nlp.LemmatizeRequest\x1a\x16.nlp.LemmatizeResponse\x12\x37\n\x08Tokenize\x12\x14.nlp.TokenizeRequest\x1a\x15.nlp.TokenizeResponse\x12O\n\x10SegmentSentences\x12\x1c.nlp.SegmentSentencesRequest\x1a\x1d.nlp.SegmentSentencesResponse\x12L\n\x0f\x45xtractEntities\x12\x1b.nlp.ExtractEntitiesRequest\x1a\x1c.nlp.ExtractEntitiesResponse\x12O\n\x10TagPartsOfSpeech\x12\x1c.nlp.TagPartsOfSpeechRequest\x1a\x1d.nlp.TagPartsOfSpeechResponse\x12I\n\x0e\x41nalyzeAspects\x12\x1a.nlp.AnalyzeAspectsRequest\x1a\x1b.nlp.AnalyzeAspectsResponseB\x1fZ\x1dgithub.com/Mannymz/ZenNLP/apib\x06proto3')
 
 _globals = globals()
 _builder.BuildMessageAndEnumDescriptors(DESCRIPTOR, _globals)
@@ -32,10 +32,70 @@ _builder.BuildTopDescriptorsAndMessages(DESCRIPTOR, 'nlp_pb2', _globals)
 if not _descriptor._USE_C_DESCRIPTORS:
   _globals['DESCRIPTOR']._loaded_options = None
   _globals['DESCRIPTOR']._serialized_options = b'Z\035github.com/Mannymz/ZenNLP/api'
+  _globals['_SENTIMENTRESPONSE_PROBABILITIESENTRY']._loaded_options = None
+  _globals['_SENTIMENTRESPONSE_PROBABILITIESENTRY']._serialized_options = b'8\001'
+  _globals['_SENTIMENTLABEL']._serialized_start=2029
+  _globals['_SENTIMENTLABEL']._serialized_end=2194
+  _globals['_TOKENKIND']._serialized_start=2197
+  _globals['_TOKENKIND']._serialized_end=2443
+  _globals['_ENTITYTYPE']._serialized_start=2446
+  _globals['_ENTITYTYPE']._serialized_end=2620
+  _globals['_PARTOFSPEECH']._serialized_start=2623
+  _globals['_PARTOFSPEECH']._serialized_end=3088
   _globals['_SENTIMENTREQUEST']._serialized_start=18
   _globals['_SENTIMENTREQUEST']._serialized_end=64
-  _globals['_SENTIMENTRESPONSE']._serialized_start=66
-  _globals['_SENTIMENTRESPONSE']._serialized_end=115
-  _globals['_NLPMANAGER']._serialized_start=117
-  _globals['_NLPMANAGER']._serialized_end=196
+  _globals['_SENTIMENTRESPONSE']._serialized_start=67
+  _globals['_SENTIMENTRESPONSE']._serialized_end=299
+  _globals['_SENTIMENTRESPONSE_PROBABILITIESENTRY']._serialized_start=247
+  _globals['_SENTIMENTRESPONSE_PROBABILITIESENTRY']._serialized_end=299
+  _globals['_SENTIMENTBATCHREQUEST']._serialized_start=301
+  _globals['_SENTIMENTBATCHREQUEST']._serialized_end=362
+  _globals['_SENTIMENTBATCHRESPONSE']._serialized_start=364
+  _globals['_SENTIMENTBATCHRESPONSE']._serialized_end=432
+  _globals['_SENTIMENTBATCHRESULT']._serialized_start=434
+  _globals['_SENTIMENTBATCHRESULT']._serialized_end=559
+  _globals['_ITEMERROR']._serialized_start=561
+  _globals['_ITEMERROR']._serialized_end=603
+  _globals['_SENTIMENTSTREAMREQUEST']._serialized_start=605
+  _globals['_SENTIMENTSTREAMREQUEST']._serialized_end=681
+  _globals['_SENTIMENTSTREAMRESPONSE']._serialized_start=683
+  _globals['_SENTIMENTSTREAMRESPONSE']._serialized_end=808
+  _globals['_LEMMATIZEREQUEST']._serialized_start=810
+  _globals['_LEMMATIZEREQUEST']._serialized_end=856
+  _globals['_LEMMA']._serialized_start=858
+  _globals['_LEMMA']._serialized_end=936
+  _globals['_LEMMATIZERESPONSE']._serialized_start=938
+  _globals['_LEMMATIZERESPONSE']._serialized_end=985
+  _globals['_TOKENIZEREQUEST']._serialized_start=987
+  _globals['_TOKENIZEREQUEST']._serialized_end=1032
+  _globals['_TOKEN']._serialized_start=1034
+  _globals['_TOKEN']._serialized_end=1113
+  _globals['_TOKENIZERESPONSE']._serialized_start=1115
+  _globals['_TOKENIZERESPONSE']._serialized_end=1161
+  _globals['_SEGMENTSENTENCESREQUEST']._serialized_start=1163
+  _globals['_SEGMENTSENTENCESREQUEST']._serialized_end=1216
+  _globals['_SENTENCE']._serialized_start=1218
+  _globals['_SENTENCE']._serialized_end=1298
+  _globals['_SEGMENTSENTENCESRESPONSE']._serialized_start=1300
+  _globals['_SEGMENTSENTENCESRESPONSE']._serialized_end=1360
+  _globals['_EXTRACTENTITIESREQUEST']._serialized_start=1362
+  _globals['_EXTRACTENTITIESREQUEST']._serialized_end=1414
+  _globals['_ENTITY']._serialized_start=1416
+  _globals['_ENTITY']._serialized_end=1517
+  _globals['_EXTRACTENTITIESRESPONSE']._serialized_start=1519
+  _globals['_EXTRACTENTITIESRESPONSE']._serialized_end=1575
+  _globals['_TAGPARTSOFSPEECHREQUEST']._serialized_start=1577
+  _globals['_TAGPARTSOFSPEECHREQUEST']._serialized_end=1630
+  _globals['_TAGGEDTOKEN']._serialized_start=1632
+  _globals['_TAGGEDTOKEN']._serialized_end=1734
+  _globals['_TAGPARTSOFSPEECHRESPONSE']._serialized_start=1736
+  _globals['_TAGPARTSOFSPEECHRESPONSE']._serialized_end=1796
+  _globals['_ANALYZEASPECTSREQUEST']._serialized_start=1798
+  _globals['_ANALYZEASPECTSREQUEST']._serialized_end=1849
+  _globals['_ASPECT']._serialized_start=1851
+  _globals['_ASPECT']._serialized_end=1970
+  _globals['_ANALYZEASPECTSRESPONSE']._serialized_start=1972
+  _globals['_ANALYZEASPECTSRESPONSE']._serialized_end=2026
+  _globals['_NLPMANAGER']._serialized_start=3091
+  _globals['_NLPMANAGER']._serialized_end=3766
 # @@protoc_insertion_point(module_scope)
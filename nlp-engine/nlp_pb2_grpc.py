@@ -39,6 +39,46 @@ class NLPManagerStub(object):
                 request_serializer=nlp__pb2.SentimentRequest.SerializeToString,
                 response_deserializer=nlp__pb2.SentimentResponse.FromString,
                 _registered_method=True)
+        self.AnalyzeSentimentBatch = channel.unary_unary(
+                '/nlp.NLPManager/AnalyzeSentimentBatch',
+                request_serializer=nlp__pb2.SentimentBatchRequest.SerializeToString,
+                response_deserializer=nlp__pb2.SentimentBatchResponse.FromString,
+                _registered_method=True)
+        self.StreamSentiment = channel.stream_stream(
+                '/nlp.NLPManager/StreamSentiment',
+                request_serializer=nlp__pb2.SentimentStreamRequest.SerializeToString,
+                response_deserializer=nlp__pb2.SentimentStreamResponse.FromString,
+                _registered_method=True)
+        self.Lemmatize = channel.unary_unary(
+                '/nlp.NLPManager/Lemmatize',
+                request_serializer=nlp__pb2.LemmatizeRequest.SerializeToString,
+                response_deserializer=nlp__pb2.LemmatizeResponse.FromString,
+                _registered_method=True)
+        self.Tokenize = channel.unary_unary(
+                '/nlp.NLPManager/Tokenize',
+                request_serializer=nlp__pb2.TokenizeRequest.SerializeToString,
+                response_deserializer=nlp__pb2.TokenizeResponse.FromString,
+                _registered_method=True)
+        self.SegmentSentences = channel.unary_unary(
+                '/nlp.NLPManager/SegmentSentences',
+                request_serializer=nlp__pb2.SegmentSentencesRequest.SerializeToString,
+                response_deserializer=nlp__pb2.SegmentSentencesResponse.FromString,
+                _registered_method=True)
+        self.ExtractEntities = channel.unary_unary(
+                '/nlp.NLPManager/ExtractEntities',
+                request_serializer=nlp__pb2.ExtractEntitiesRequest.SerializeToString,
+                response_deserializer=nlp__pb2.ExtractEntitiesResponse.FromString,
+                _registered_method=True)
+        self.TagPartsOfSpeech = channel.unary_unary(
+                '/nlp.NLPManager/TagPartsOfSpeech',
+                request_serializer=nlp__pb2.TagPartsOfSpeechRequest.SerializeToString,
+                response_deserializer=nlp__pb2.TagPartsOfSpeechResponse.FromString,
+                _registered_method=True)
+        self.AnalyzeAspects = channel.unary_unary(
+                '/nlp.NLPManager/AnalyzeAspects',
+                request_serializer=nlp__pb2.AnalyzeAspectsRequest.SerializeToString,
+                response_deserializer=nlp__pb2.AnalyzeAspectsResponse.FromString,
+                _registered_method=True)
 
 
 class NLPManagerServicer(object):
@@ -50,6 +90,54 @@ class NLPManagerServicer(object):
         context.set_details('Method not implemented!')
         raise NotImplementedError('Method not implemented!')
 
+    def AnalyzeSentimentBatch(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def StreamSentiment(self, request_iterator, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Lemmatize(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def Tokenize(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def SegmentSentences(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def ExtractEntities(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def TagPartsOfSpeech(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
+    def AnalyzeAspects(self, request, context):
+        """Missing associated documentation comment in .proto file."""
+        context.set_code(grpc.StatusCode.UNIMPLEMENTED)
+        context.set_details('Method not implemented!')
+        raise NotImplementedError('Method not implemented!')
+
 
 def add_NLPManagerServicer_to_server(servicer, server):
     rpc_method_handlers = {
@@ -58,6 +146,46 @@ def add_NLPManagerServicer_to_server(servicer, server):
                     request_deserializer=nlp__pb2.SentimentRequest.FromString,
                     response_serializer=nlp__pb2.SentimentResponse.SerializeToString,
             ),
+            'AnalyzeSentimentBatch': grpc.unary_unary_rpc_method_handler(
+                    servicer.AnalyzeSentimentBatch,
+                    request_deserializer=nlp__pb2.SentimentBatchRequest.FromString,
+                    response_serializer=nlp__pb2.SentimentBatchResponse.SerializeToString,
+            ),
+            'StreamSentiment': grpc.stream_stream_rpc_method_handler(
+                    servicer.StreamSentiment,
+                    request_deserializer=nlp__pb2.SentimentStreamRequest.FromString,
+                    response_serializer=nlp__pb2.SentimentStreamResponse.SerializeToString,
+            ),
+            'Lemmatize': grpc.unary_unary_rpc_method_handler(
+                    servicer.Lemmatize,
+                    request_deserializer=nlp__pb2.LemmatizeRequest.FromString,
+                    response_serializer=nlp__pb2.LemmatizeResponse.SerializeToString,
+            ),
+            'Tokenize': grpc.unary_unary_rpc_method_handler(
+                    servicer.Tokenize,
+                    request_deserializer=nlp__pb2.TokenizeRequest.FromString,
+                    response_serializer=nlp__pb2.TokenizeResponse.SerializeToString,
+            ),
+            'SegmentSentences': grpc.unary_unary_rpc_method_handler(
+                    servicer.SegmentSentences,
+                    request_deserializer=nlp__pb2.SegmentSentencesRequest.FromString,
+                    response_serializer=nlp__pb2.SegmentSentencesResponse.SerializeToString,
+            ),
+            'ExtractEntities': grpc.unary_unary_rpc_method_handler(
+                    servicer.ExtractEntities,
+                    request_deserializer=nlp__pb2.ExtractEntitiesRequest.FromString,
+                    response_serializer=nlp__pb2.ExtractEntitiesResponse.SerializeToString,
+            ),
+            'TagPartsOfSpeech': grpc.unary_unary_rpc_method_handler(
+                    servicer.TagPartsOfSpeech,
+                    request_deserializer=nlp__pb2.TagPartsOfSpeechRequest.FromString,
+                    response_serializer=nlp__pb2.TagPartsOfSpeechResponse.SerializeToString,
+            ),
+            'AnalyzeAspects': grpc.unary_unary_rpc_method_handler(
+                    servicer.AnalyzeAspects,
+                    request_deserializer=nlp__pb2.AnalyzeAspectsRequest.FromString,
+                    response_serializer=nlp__pb2.AnalyzeAspectsResponse.SerializeToString,
+            ),
     }
     generic_handler = grpc.method_handlers_generic_handler(
             'nlp.NLPManager', rpc_method_handlers)
@@ -95,3 +223,219 @@ class NLPManager(object):
             timeout,
             metadata,
             _registered_method=True)
+
+    @staticmethod
+    def AnalyzeSentimentBatch(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/AnalyzeSentimentBatch',
+            nlp__pb2.SentimentBatchRequest.SerializeToString,
+            nlp__pb2.SentimentBatchResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def StreamSentiment(request_iterator,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.stream_stream(
+            request_iterator,
+            target,
+            '/nlp.NLPManager/StreamSentiment',
+            nlp__pb2.SentimentStreamRequest.SerializeToString,
+            nlp__pb2.SentimentStreamResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Lemmatize(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/Lemmatize',
+            nlp__pb2.LemmatizeRequest.SerializeToString,
+            nlp__pb2.LemmatizeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def Tokenize(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/Tokenize',
+            nlp__pb2.TokenizeRequest.SerializeToString,
+            nlp__pb2.TokenizeResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def SegmentSentences(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/SegmentSentences',
+            nlp__pb2.SegmentSentencesRequest.SerializeToString,
+            nlp__pb2.SegmentSentencesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def ExtractEntities(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/ExtractEntities',
+            nlp__pb2.ExtractEntitiesRequest.SerializeToString,
+            nlp__pb2.ExtractEntitiesResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def TagPartsOfSpeech(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/TagPartsOfSpeech',
+            nlp__pb2.TagPartsOfSpeechRequest.SerializeToString,
+            nlp__pb2.TagPartsOfSpeechResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
+
+    @staticmethod
+    def AnalyzeAspects(request,
+            target,
+            options=(),
+            channel_credentials=None,
+            call_credentials=None,
+            insecure=False,
+            compression=None,
+            wait_for_ready=None,
+            timeout=None,
+            metadata=None):
+        return grpc.experimental.unary_unary(
+            request,
+            target,
+            '/nlp.NLPManager/AnalyzeAspects',
+            nlp__pb2.AnalyzeAspectsRequest.SerializeToString,
+            nlp__pb2.AnalyzeAspectsResponse.FromString,
+            options,
+            channel_credentials,
+            insecure,
+            call_credentials,
+            compression,
+            wait_for_ready,
+            timeout,
+            metadata,
+            _registered_method=True)
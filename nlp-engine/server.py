@@ -4,6 +4,8 @@ import os
 sys.path.append(os.path.dirname(__file__))
 
 import grpc
+from grpc_health.v1 import health, health_pb2, health_pb2_grpc
+from grpc_reflection.v1alpha import reflection
 import logging
 from concurrent import futures
 import time
@@ -14,62 +16,138 @@ import numpy as np
 import nlp_pb2
 import nlp_pb2_grpc
 
+SERVICE_NAME = nlp_pb2.DESCRIPTOR.services_by_name['NLPManager'].full_name
+
 class NLPManagerServicer(nlp_pb2_grpc.NLPManagerServicer):
     def __init__(self):
-        logging.info("Loading ParsBERT model...")
         self.model_name = "HooshvareLab/bert-fa-base-uncased-sentiment-snappfood"
+        self.tokenizer = None
+        self.model = None
+        self.labels = ["negative", "positive"]
+        self.sentiments = {
+            "negative": nlp_pb2.SENTIMENT_LABEL_NEGATIVE,
+            "positive": nlp_pb2.SENTIMENT_LABEL_POSITIVE,
+        }
+    
+    def load(self):
+        logging.info("Loading ParsBERT model...")
         self.tokenizer = AutoTokenizer.from_pretrained(self.model_name, force_download=True)
         self.model = AutoModelForSequenceClassification.from_pretrained(self.model_name, force_download=True)
-        self.labels = ["negative", "positive"]
         logging.info("Model loaded successfully")
     
+    def _check_loaded(self, context):
+        # Calls can arrive while the model is still loading; the health service reports NOT_SERVING until then
+        if self.model is None:
+            context.abort(grpc.StatusCode.UNAVAILABLE, "model is still loading")
+    
+    def _predict(self, text):
+        # Tokenize input text
+        inputs = self.tokenizer(
+            text, 
+            return_tensors="pt", 
+            truncation=True, 
+            padding=True, 
+            max_length=512
+        )
+        
+        # Get model predictions
+        with torch.no_grad():
+            outputs = self.model(**inputs)
+            logits = outputs.logits
+            probabilities = torch.softmax(logits, dim=-1)
+            predicted_class = torch.argmax(probabilities, dim=-1).item()
+            confidence = probabilities[0][predicted_class].item()
+        
+        # Map to label and score
+        label = self.labels[predicted_class]
+        score = float(confidence)
+        distribution = {
+            name: float(probabilities[0][i].item())
+            for i, name in enumerate(self.labels)
+        }
+        
+        logging.info(f"Predicted sentiment: {label} with confidence: {score:.4f}")
+        
+        return nlp_pb2.SentimentResponse(
+            label=label,
+            score=score,
+            sentiment=self.sentiments[label],
+            probabilities=distribution,
+            model_version=self.model_name
+        )
+    
     def AnalyzeSentiment(self, request, context):
+        self._check_loaded(context)
         logging.info(f"Analyzing sentiment for text: '{request.text}' in language: '{request.lang}'")
         
         try:
-            # Tokenize input text
-            inputs = self.tokenizer(
-                request.text, 
-                return_tensors="pt", 
-                truncation=True, 
-                padding=True, 
-                max_length=512
-            )
-            
-            # Get model predictions
-            with torch.no_grad():
-                outputs = self.model(**inputs)
-                logits = outputs.logits
-                probabilities = torch.softmax(logits, dim=-1)
-                predicted_class = torch.argmax(probabilities, dim=-1).item()
-                confidence = probabilities[0][predicted_class].item()
-            
-            # Map to label and score
-            label = self.labels[predicted_class]
-            score = float(confidence)
-            
-            logging.info(f"Predicted sentiment: {label} with confidence: {score:.4f}")
-            
-            return nlp_pb2.SentimentResponse(label=label, score=score)
+            return self._predict(request.text)
             
         except Exception as e:
             logging.error(f"Error during sentiment analysis: {str(e)}")
             context.set_code(grpc.StatusCode.INTERNAL)
             context.set_details(f"Sentiment analysis failed: {str(e)}")
             return nlp_pb2.SentimentResponse(label="error", score=0.0)
+    
+    def AnalyzeSentimentBatch(self, request, context):
+        self._check_loaded(context)
+        logging.info(f"Analyzing sentiment for batch of {len(request.items)} texts")
+        
+        results = []
+        for index, item in enumerate(request.items):
+            try:
+                response = self._predict(item.text)
+                results.append(nlp_pb2.SentimentBatchResult(index=index, response=response))
+            except Exception as e:
+                logging.error(f"Error during sentiment analysis of batch item {index}: {str(e)}")
+                error = nlp_pb2.ItemError(
+                    code=grpc.StatusCode.INTERNAL.value[0],
+                    message=f"Sentiment analysis failed: {str(e)}"
+                )
+                results.append(nlp_pb2.SentimentBatchResult(index=index, error=error))
+        
+        return nlp_pb2.SentimentBatchResponse(results=results)
+    
+    def StreamSentiment(self, request_iterator, context):
+        self._check_loaded(context)
+        # Requests are pulled one at a time, so a slow model applies gRPC flow control to the client
+        for stream_request in request_iterator:
+            try:
+                response = self._predict(stream_request.request.text)
+                yield nlp_pb2.SentimentStreamResponse(id=stream_request.id, response=response)
+            except Exception as e:
+                logging.error(f"Error during sentiment analysis of stream request {stream_request.id}: {str(e)}")
+                error = nlp_pb2.ItemError(
+                    code=grpc.StatusCode.INTERNAL.value[0],
+                    message=f"Sentiment analysis failed: {str(e)}"
+                )
+                yield nlp_pb2.SentimentStreamResponse(id=stream_request.id, error=error)
 
 def serve():
     server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
-    nlp_pb2_grpc.add_NLPManagerServicer_to_server(NLPManagerServicer(), server)
+    servicer = NLPManagerServicer()
+    nlp_pb2_grpc.add_NLPManagerServicer_to_server(servicer, server)
+    
+    # Health and reflection are available while the model loads, reporting NOT_SERVING until it is ready
+    health_servicer = health.HealthServicer()
+    health_pb2_grpc.add_HealthServicer_to_server(health_servicer, server)
+    for name in ("", SERVICE_NAME):
+        health_servicer.set(name, health_pb2.HealthCheckResponse.NOT_SERVING)
+    reflection.enable_server_reflection((SERVICE_NAME, health.SERVICE_NAME, reflection.SERVICE_NAME), server)
     
     server.add_insecure_port('[::]:50051')
     logging.info("Starting gRPC server on port 50051")
     
     server.start()
+    servicer.load()
+    for name in ("", SERVICE_NAME):
+        health_servicer.set(name, health_pb2.HealthCheckResponse.SERVING)
+    
     try:
         while True:
             time.sleep(86400)  # One day
     except KeyboardInterrupt:
+        health_servicer.enter_graceful_shutdown()
         server.stop(0)
 
 if __name__ == '__main__':
@@ -1,4 +1,6 @@
 import grpc
+from grpc_health.v1 import health, health_pb2, health_pb2_grpc
+from grpc_reflection.v1alpha import reflection
 import logging
 from concurrent import futures
 import time
@@ -6,29 +8,62 @@ import time
 import nlp_pb2
 import nlp_pb2_grpc
 
+SERVICE_NAME = nlp_pb2.DESCRIPTOR.services_by_name['NLPManager'].full_name
+
 class NLPManagerServicer(nlp_pb2_grpc.NLPManagerServicer):
-    def AnalyzeSentiment(self, request, context):
-        logging.info(f"Analyzing sentiment for text: '{request.text}' in language: '{request.lang}'")
-        
+    def _mock_analyze(self, text):
         # Simple mock analysis for testing
-        text_lower = request.text.lower()
+        text_lower = text.lower()
         
         if any(word in text_lower for word in ['عالی', 'خوب', 'راضی', 'عالی است']):
             label = "positive"
+            sentiment = nlp_pb2.SENTIMENT_LABEL_POSITIVE
             score = 0.85
         elif any(word in text_lower for word in ['بد', 'ناامید', 'توصیه نمی‌کنم']):
             label = "negative"
+            sentiment = nlp_pb2.SENTIMENT_LABEL_NEGATIVE
             score = 0.15
         else:
             label = "neutral"
+            sentiment = nlp_pb2.SENTIMENT_LABEL_NEUTRAL
             score = 0.50
         
-        return nlp_pb2.SentimentResponse(label=label, score=score)
+        return nlp_pb2.SentimentResponse(
+            label=label,
+            score=score,
+            sentiment=sentiment,
+            probabilities={label: score},
+            model_version="mock-1"
+        )
+    
+    def AnalyzeSentiment(self, request, context):
+        logging.info(f"Analyzing sentiment for text: '{request.text}' in language: '{request.lang}'")
+        return self._mock_analyze(request.text)
+    
+    def AnalyzeSentimentBatch(self, request, context):
+        logging.info(f"Analyzing sentiment for batch of {len(request.items)} texts")
+        results = [
+            nlp_pb2.SentimentBatchResult(index=index, response=self._mock_analyze(item.text))
+            for index, item in enumerate(request.items)
+        ]
+        return nlp_pb2.SentimentBatchResponse(results=results)
+    
+    def StreamSentiment(self, request_iterator, context):
+        for stream_request in request_iterator:
+            response = self._mock_analyze(stream_request.request.text)
+            yield nlp_pb2.SentimentStreamResponse(id=stream_request.id, response=response)
 
 def serve():
     server = grpc.server(futures.ThreadPoolExecutor(max_workers=10))
     nlp_pb2_grpc.add_NLPManagerServicer_to_server(NLPManagerServicer(), server)
     
+    # The mock needs no model, so it reports SERVING right away
+    health_servicer = health.HealthServicer()
+    health_pb2_grpc.add_HealthServicer_to_server(health_servicer, server)
+    for name in ("", SERVICE_NAME):
+        health_servicer.set(name, health_pb2.HealthCheckResponse.SERVING)
+    reflection.enable_server_reflection((SERVICE_NAME, health.SERVICE_NAME, reflection.SERVICE_NAME), server)
+    
     server.add_insecure_port('localhost:50051')
     logging.info("Starting gRPC server on port 50051")
     